@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os/signal"
+	"syscall"
 
 	"github.com/cnc-csku/task-nexus-go-lib/logger"
 	"github.com/cnc-csku/task-nexus/task-management/internal/wire"
@@ -10,55 +13,13 @@ import (
 func main() {
 	logger := logger.NewLogrusLogger()
 
-	app := wire.InitializeApp()
-
-	// grpcServer := wire.InitializeGrpcServer()
-	// defer grpcServer.Server.Stop()
-
-	// // create a listener on TCP port
-	// lis, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcServer.Config.GrpcServer.Port))
-	// if err != nil {
-	// 	log.Fatalf("failed to listen: %v", err)
-	// }
-	// defer lis.Close()
-
-	// // create a context that will be can	celed when SIGINT or SIGTERM is received
-	// ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
-	// defer stop()
-
-	// // get local IP address to display on startup
-	// localIP, _ := network.GetLocalIP()
-
-	// // start gRPC server
-	// grpcReady := make(chan bool)
-	// go func() {
-	// 	log.Printf("✅ gRPC server is running on %s:%s", localIP, grpcServer.Config.GrpcServer.Port)
-	// 	close(grpcReady)
+	// create a context that will be canceled when SIGINT or SIGTERM is received
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	// 	if err := grpcServer.Server.Serve(lis); err != nil {
-	// 		log.Fatalf("failed to serve: %v", err)
-	// 	}
-	// }()
-
-	// start the rest server
-	// go func() {
-	// <-grpcReady // wait for grpc server to be ready
+	app := wire.InitializeApp()
 
-	err := app.Start(logger)
-	if err != nil {
+	if err := app.Run(ctx, logger); err != nil {
 		log.Fatalln(err)
 	}
-	// }()
-
-	// // wait for SIGINT or SIGTERM
-	// <-ctx.Done()
-
-	// // gracefully shutdown gRPC server
-	// grpcServer.Server.GracefulStop()
-
-	// // cancel context after the server gracefully stopped
-	// stop()
-
-	// // close all gRPC client connections
-	// core_grpcclient.CloseAllGrpcConnections()
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	coreGrpcClient "github.com/cnc-csku/task-nexus-go-lib/grpcclient"
@@ -9,16 +10,22 @@ import (
 )
 
 type Config struct {
-	ServiceName  string                          `env:"SERVICE_NAME"`
-	AllowOrigins []string                        `env:"ALLOW_ORIGINS" envSeparator:","`
-	RestServer   RestServerConfig                `envPrefix:"REST_SERVER_"`
-	MongoDB      MongoDBConfig                   `envPrefix:"MONGO_"`
-	GrpcServer   GrpcServerConfig                `envPrefix:"GRPC_SERVER_"`
-	GrpcClient   coreGrpcClient.GrpcClientConfig `envPrefix:"GRPC_CLIENT_"`
-	OllamaClient OllamaClientConfig              `envPrefix:"OLLAMA_CLIENT_"`
-	JWT          JWT                             `envPrefix:"JWT_"`
-	Redis        RedisConfig                     `envPrefix:"REDIS_"`
-	LogFormat    string                          `env:"LOG_FORMAT"`
+	ServiceName        string                          `env:"SERVICE_NAME"`
+	AllowOrigins       []string                        `env:"ALLOW_ORIGINS" envSeparator:","`
+	RestServer         RestServerConfig                `envPrefix:"REST_SERVER_"`
+	MongoDB            MongoDBConfig                   `envPrefix:"MONGO_"`
+	GrpcServer         GrpcServerConfig                `envPrefix:"GRPC_SERVER_"`
+	GrpcClient         coreGrpcClient.GrpcClientConfig `envPrefix:"GRPC_CLIENT_"`
+	LLM                LLMConfig                       `envPrefix:"LLM_"`
+	OllamaClient       OllamaClientConfig              `envPrefix:"OLLAMA_CLIENT_"`
+	OpenAIClient       OpenAIClientConfig              `envPrefix:"OPENAI_CLIENT_"`
+	JWT                JWT                             `envPrefix:"JWT_"`
+	Redis              RedisConfig                     `envPrefix:"REDIS_"`
+	Webhook            WebhookConfig                   `envPrefix:"WEBHOOK_"`
+	HealthCheck        HealthCheckConfig               `envPrefix:"HEALTH_CHECK_"`
+	LogFormat          string                          `env:"LOG_FORMAT"`
+	ShutdownTimeout    time.Duration                   `env:"SHUTDOWN_TIMEOUT" envDefault:"15s"`
+	ShutdownDrainDelay time.Duration                   `env:"SHUTDOWN_DRAIN_DELAY" envDefault:"5s"`
 }
 
 type RestServerConfig struct {
@@ -37,6 +44,26 @@ type GrpcServerConfig struct {
 	UseReflection  bool   `env:"USE_REFLECTION"`
 }
 
+const (
+	LLMProviderOllama = "ollama"
+	LLMProviderOpenAI = "openai"
+)
+
+type LLMConfig struct {
+	Provider       string         `env:"PROVIDER" envDefault:"ollama"`
+	RequestTimeout time.Duration  `env:"REQUEST_TIMEOUT" envDefault:"60s"`
+	StreamTimeout  time.Duration  `env:"STREAM_TIMEOUT" envDefault:"5m"`
+	Model          LLMModelConfig `envPrefix:"MODEL_"`
+}
+
+// LLMModelConfig picks the model of each AI feature, features without a model use Default
+type LLMModelConfig struct {
+	Default       string `env:"DEFAULT" envDefault:"llama3.2"`
+	TaskBreakdown string `env:"TASK_BREAKDOWN"`
+	SprintSummary string `env:"SPRINT_SUMMARY"`
+	Embedding     string `env:"EMBEDDING" envDefault:"nomic-embed-text"`
+}
+
 type OllamaClientConfig struct {
 	Endpoint      string `env:"ENDPOINT"`
 	UseProxy      bool   `env:"USE_PROXY"`
@@ -44,15 +71,37 @@ type OllamaClientConfig struct {
 	HttpProxyPort string `env:"HTTP_PROXY_PORT"`
 }
 
+// OpenAIClientConfig works with any server that implements the OpenAI chat completions and embeddings API
+type OpenAIClientConfig struct {
+	BaseURL string `env:"BASE_URL" envDefault:"https://api.openai.com/v1"`
+	APIKey  string `env:"API_KEY"`
+}
+
 type JWT struct {
-	AccessTokenSecret  string `env:"ACCESS_TOKEN_SECRET"`
-	RefreshTokenSecret string `env:"REFRESH_TOKEN_SECRET"`
+	AccessTokenSecret     string        `env:"ACCESS_TOKEN_SECRET"`
+	RefreshTokenSecret    string        `env:"REFRESH_TOKEN_SECRET"`
+	AccessTokenExpiresIn  time.Duration `env:"ACCESS_TOKEN_EXPIRES_IN" envDefault:"15m"`
+	RefreshTokenExpiresIn time.Duration `env:"REFRESH_TOKEN_EXPIRES_IN" envDefault:"168h"`
 }
 
 type RedisConfig struct {
 	URI string `env:"URI"`
 }
 
+type HealthCheckConfig struct {
+	Timeout  time.Duration `env:"TIMEOUT" envDefault:"2s"`
+	Interval time.Duration `env:"INTERVAL" envDefault:"10s"`
+}
+
+type WebhookConfig struct {
+	MaxAttempts    int           `env:"MAX_ATTEMPTS" envDefault:"6"`
+	InitialBackoff time.Duration `env:"INITIAL_BACKOFF" envDefault:"30s"`
+	RequestTimeout time.Duration `env:"REQUEST_TIMEOUT" envDefault:"10s"`
+	PollInterval   time.Duration `env:"POLL_INTERVAL" envDefault:"5s"`
+	// AllowPrivateNetworks lets webhooks reach loopback and private addresses, only meant for local development
+	AllowPrivateNetworks bool `env:"ALLOW_PRIVATE_NETWORKS" envDefault:"false"`
+}
+
 func NewConfig() *Config {
 	// Load environment variables from .env file
 	if err := godotenv.Load(); err != nil {
@@ -16,8 +16,46 @@ const (
 	InvitationExpirationIn = 7 * (24 * time.Hour) // 7 days
 )
 
+const (
+	MIMETextEventStream = "text/event-stream"
+
+	// EventStreamKeepAliveInterval keeps idle event streams open through proxies
+	EventStreamKeepAliveInterval = 15 * time.Second
+
+	// AI streams send the model output in token events and end with a result or an error event
+	AIStreamEventToken  = "token"
+	AIStreamEventResult = "result"
+	AIStreamEventError  = "error"
+)
+
+const (
+	// SimilarTaskDuplicateThreshold is the cosine similarity above which a new task is reported as a likely duplicate
+	SimilarTaskDuplicateThreshold = 0.85
+	SimilarTaskDefaultLimit       = 10
+	SimilarTaskMaxLimit           = 50
+
+	// SimilarTaskBackfillSize limits how many tasks without an embedding are indexed before each search
+	SimilarTaskBackfillSize = 50
+
+	// Tasks that do not fit in the index queue keep a stale embedding until the backfill of the next search
+	SimilarTaskIndexQueueSize = 256
+	SimilarTaskIndexWorkers   = 2
+)
+
+const (
+	// NotificationPublishTimeout bounds how long a mutation waits for the notification service to accept a notification
+	NotificationPublishTimeout = 3 * time.Second
+)
+
+const (
+	// Sprint summaries only include the latest comments of each task to keep the prompt small
+	SprintSummaryMaxCommentsPerTask = 5
+	SprintSummaryMaxCommentLength   = 300
+)
+
 const (
 	TimeFormat = time.RFC3339
+	DateFormat = time.DateOnly
 )
 
 // Service constants
@@ -42,3 +80,34 @@ const (
 	ProjectMemberFieldDisplayName = "display_name"
 	ProjectMemberFieldJoinedAt    = "joined_at"
 )
+
+const (
+	TaskFieldTaskID    = "task_id"
+	TaskFieldTitle     = "title"
+	TaskFieldStatus    = "status"
+	TaskFieldPriority  = "priority"
+	TaskFieldCreatedAt = "created_at"
+	TaskFieldUpdatedAt = "updated_at"
+)
+
+const (
+	SprintFieldTitle     = "title"
+	SprintFieldStartDate = "start_date"
+	SprintFieldEndDate   = "end_date"
+	SprintFieldCreatedAt = "created_at"
+)
+
+const (
+	ActivityFieldCreatedAt = "created_at"
+)
+
+const (
+	NotificationFieldCreatedAt = "created_at"
+)
+
+const (
+	WebhookDeliveryFieldCreatedAt = "created_at"
+)
+
+// TaskSprintBacklog is used as a sprint filter value to select tasks that are not in any sprint
+const TaskSprintBacklog = "backlog"
@@ -0,0 +1,7 @@
+package exceptions
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidActivityEntityType = errors.New("invalid activity entity type")
+)
@@ -0,0 +1,11 @@
+package exceptions
+
+import "github.com/pkg/errors"
+
+var (
+	ErrAIUnavailable          = errors.New("AI model is unavailable")
+	ErrAITimeout              = errors.New("AI model did not respond in time")
+	ErrInvalidAIResponse      = errors.New("AI model returned an invalid response")
+	ErrTaskCannotBeBrokenDown = errors.New("sub-tasks can not be broken down further")
+	ErrEmptyTaskBreakdown     = errors.New("no valid child tasks were proposed")
+)
@@ -0,0 +1,7 @@
+package exceptions
+
+import "github.com/pkg/errors"
+
+var (
+	ErrEventStreamNotAccepted = errors.New("request must accept text/event-stream")
+)
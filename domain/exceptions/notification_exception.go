@@ -0,0 +1,7 @@
+package exceptions
+
+import "github.com/pkg/errors"
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
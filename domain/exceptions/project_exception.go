@@ -8,4 +8,16 @@ var (
 	ErrProjectNotFound            = errors.New("project not found")
 	ErrDefaultWorkflowNotFound    = errors.New("default workflow not found")
 	ErrInvalidAttributeType       = errors.New("invalid attribute type")
+	ErrWorkflowNotFound           = errors.New("workflow not found")
+	ErrWorkflowAlreadyExists      = errors.New("workflow status already exists")
+	ErrInvalidWorkflowStatus      = errors.New("workflow status must not be empty")
+	ErrMultipleDefaultWorkflows   = errors.New("only one workflow can be the default")
+	ErrPreviousStatusNotFound     = errors.New("previous status not found in project workflows")
+	ErrUnreachableWorkflow        = errors.New("workflow status is not reachable from the default status")
+	ErrDeleteDefaultWorkflow      = errors.New("default workflow can not be deleted")
+	ErrTargetStatusRequired       = errors.New("target status is required to move the existing tasks")
+	ErrInvalidTargetStatus        = errors.New("target status must be another status of the project workflows")
+	ErrInvalidWorkflowOrder       = errors.New("workflow order must list every status exactly once")
+	ErrPositionNotFound           = errors.New("position is not defined in the project")
+	ErrWorkflowConflict           = errors.New("project was changed by someone else, reload the workflows and try again")
 )
@@ -3,5 +3,14 @@ package exceptions
 import "github.com/pkg/errors"
 
 var (
-	ErrSprintNotFound = errors.New("sprint not found")
+	ErrSprintNotFound        = errors.New("sprint not found")
+	ErrSprintAlreadyActive   = errors.New("another sprint is already active in the project")
+	ErrSprintNotPlanned      = errors.New("sprint is not planned")
+	ErrSprintNotActive       = errors.New("sprint is not active")
+	ErrSprintAlreadyComplete = errors.New("sprint is already completed")
+	ErrInvalidNextSprint     = errors.New("invalid next sprint")
+	ErrInvalidSprintStatus   = errors.New("invalid sprint status")
+	ErrInvalidSprintDate     = errors.New("invalid sprint date")
+	ErrSprintNotStarted      = errors.New("sprint has not started yet")
+	ErrSprintHasNoTasks      = errors.New("sprint has no tasks")
 )
@@ -7,4 +7,26 @@ var (
 	ErrInvalidTaskType       = errors.New("invalid task type")
 	ErrParentTaskNotFound    = errors.New("parent task not found")
 	ErrInvalidParentTaskType = errors.New("invalid parent task type")
+	ErrInvalidTaskPriority   = errors.New("invalid task priority")
+	ErrInvalidTaskStatus     = errors.New("invalid task status")
+	ErrInvalidStatusChange   = errors.New("status change is not allowed by the workflow")
+	ErrInvalidAssigneeRole   = errors.New("invalid assignee role")
+	ErrInvalidAssigneePoint  = errors.New("assignee point must not be negative")
+	ErrAssigneeNotMember     = errors.New("assignee is not a member of the project")
+	ErrAssigneeAlreadyExists = errors.New("assignee already exists")
+	ErrAssigneeNotFound      = errors.New("assignee not found")
+	ErrAttributeNotFound     = errors.New("attribute is not defined in the project")
+	ErrDuplicateAttribute    = errors.New("attribute is provided more than once")
+	ErrInvalidAttributeValue = errors.New("invalid attribute value")
+	ErrTaskCommentNotFound   = errors.New("task comment not found")
+	ErrTaskCommentDeleted    = errors.New("task comment is deleted")
+	ErrInvalidParentComment  = errors.New("replies can only be added to a top-level comment of the same task")
+	ErrPossibleDuplicateTask = errors.New("a similar task already exists")
+	ErrRequiredAttributes    = errors.New("required attributes are missing for this status")
+	ErrNotEnoughApprovals    = errors.New("task does not have enough approvals for this status")
+	ErrStatusRoleNotAllowed  = errors.New("your project role is not allowed to move tasks to this status")
+	ErrStatusPositionDenied  = errors.New("your position is not allowed to move tasks to this status")
+	ErrStatusAssigneeOnly    = errors.New("only assignees can move the task to this status")
+	ErrSelfApproval          = errors.New("assignees and the creator of a task cannot approve it")
+	ErrTaskStatusConflict    = errors.New("task status was changed by someone else, reload the task and try again")
 )
@@ -6,5 +6,6 @@ var (
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrInvalidCredentials = errors.New("invalid username or password")
 	ErrInvalidToken       = errors.New("invalid token")
+	ErrTokenRevoked       = errors.New("token has been revoked")
 	ErrUserNotFound       = errors.New("user not found")
 )
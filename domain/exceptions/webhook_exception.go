@@ -0,0 +1,11 @@
+package exceptions
+
+import "github.com/pkg/errors"
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookEvent     = errors.New("invalid webhook event")
+	ErrInvalidWebhookURL       = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookURLNotAllowed    = errors.New("webhook url must resolve to a public address")
+)
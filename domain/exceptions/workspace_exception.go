@@ -4,6 +4,7 @@ import "github.com/pkg/errors"
 
 var (
 	ErrInvalidWorkspaceID        = errors.New("invalid workspace ID")
+	ErrWorkspaceNotFound         = errors.New("workspace not found")
 	ErrMemberNotFoundInWorkspace = errors.New("member not found in workspace")
 	ErrMemberAlreadyInWorkspace  = errors.New("member already in workspace")
 )
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type Activity struct {
+	ID         bson.ObjectID      `bson:"_id" json:"id"`
+	ProjectID  bson.ObjectID      `bson:"project_id" json:"projectId"`
+	TaskID     *string            `bson:"task_id" json:"taskId"`
+	EntityType ActivityEntityType `bson:"entity_type" json:"entityType"`
+	EntityID   string             `bson:"entity_id" json:"entityId"`
+	Action     ActivityAction     `bson:"action" json:"action"`
+	Changes    []ActivityChange   `bson:"changes" json:"changes"`
+	CreatedAt  time.Time          `bson:"created_at" json:"createdAt"`
+	CreatedBy  bson.ObjectID      `bson:"created_by" json:"createdBy"`
+}
+
+type ActivityChange struct {
+	Field    string      `bson:"field" json:"field"`
+	OldValue interface{} `bson:"old_value" json:"oldValue"`
+	NewValue interface{} `bson:"new_value" json:"newValue"`
+}
+
+type ActivityEntityType string
+
+const (
+	ActivityEntityTypeProject     ActivityEntityType = "PROJECT"
+	ActivityEntityTypeSprint      ActivityEntityType = "SPRINT"
+	ActivityEntityTypeTask        ActivityEntityType = "TASK"
+	ActivityEntityTypeTaskComment ActivityEntityType = "TASK_COMMENT"
+)
+
+func (a ActivityEntityType) String() string {
+	return string(a)
+}
+
+func (a ActivityEntityType) IsValid() bool {
+	switch a {
+	case ActivityEntityTypeProject, ActivityEntityTypeSprint, ActivityEntityTypeTask, ActivityEntityTypeTaskComment:
+		return true
+	}
+	return false
+}
+
+type ActivityAction string
+
+const (
+	ActivityActionCreated ActivityAction = "CREATED"
+	ActivityActionUpdated ActivityAction = "UPDATED"
+	ActivityActionDeleted ActivityAction = "DELETED"
+)
+
+func (a ActivityAction) String() string {
+	return string(a)
+}
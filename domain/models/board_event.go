@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// BoardEvent is streamed to clients watching a project board so they can refresh without polling
+type BoardEvent struct {
+	Type      BoardEventType `json:"type"`
+	ProjectID bson.ObjectID  `json:"projectId"`
+	ActorID   bson.ObjectID  `json:"actorId"`
+	Payload   interface{}    `json:"payload"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+type BoardEventType string
+
+const (
+	BoardEventTypeTaskCreated    BoardEventType = "task.created"
+	BoardEventTypeTaskUpdated    BoardEventType = "task.updated"
+	BoardEventTypeCommentCreated BoardEventType = "comment.created"
+	BoardEventTypeSprintUpdated  BoardEventType = "sprint.updated"
+	// BoardEventTypeWorkflowUpdated means the columns changed and tasks may have been moved, the board should be reloaded
+	BoardEventTypeWorkflowUpdated BoardEventType = "workflow.updated"
+)
+
+func (b BoardEventType) String() string {
+	return string(b)
+}
@@ -0,0 +1,12 @@
+package models
+
+type HealthStatus string
+
+const (
+	HealthStatusUp   HealthStatus = "UP"
+	HealthStatusDown HealthStatus = "DOWN"
+)
+
+func (h HealthStatus) String() string {
+	return string(h)
+}
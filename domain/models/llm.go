@@ -0,0 +1,19 @@
+package models
+
+// LLMMessage is one turn of a chat with a language model
+type LLMMessage struct {
+	Role    LLMMessageRole `json:"role"`
+	Content string         `json:"content"`
+}
+
+type LLMMessageRole string
+
+const (
+	LLMMessageRoleSystem    LLMMessageRole = "system"
+	LLMMessageRoleUser      LLMMessageRole = "user"
+	LLMMessageRoleAssistant LLMMessageRole = "assistant"
+)
+
+func (l LLMMessageRole) String() string {
+	return string(l)
+}
@@ -0,0 +1,9 @@
+package models
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// Mention is a resolved @displayName or @userId reference to a project member
+type Mention struct {
+	UserID      bson.ObjectID `bson:"user_id" json:"userId"`
+	DisplayName string        `bson:"display_name" json:"displayName"`
+}
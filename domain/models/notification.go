@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type Notification struct {
+	ID          bson.ObjectID    `bson:"_id" json:"id"`
+	UserID      bson.ObjectID    `bson:"user_id" json:"userId"`
+	Type        NotificationType `bson:"type" json:"type"`
+	Message     string           `bson:"message" json:"message"`
+	WorkspaceID *bson.ObjectID   `bson:"workspace_id" json:"workspaceId"`
+	ProjectID   *bson.ObjectID   `bson:"project_id" json:"projectId"`
+	TaskID      *string          `bson:"task_id" json:"taskId"`
+	SprintID    *bson.ObjectID   `bson:"sprint_id" json:"sprintId"`
+	ActorID     bson.ObjectID    `bson:"actor_id" json:"actorId"`
+	ReadAt      *time.Time       `bson:"read_at" json:"readAt"`
+	CreatedAt   time.Time        `bson:"created_at" json:"createdAt"`
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeInvitationReceived NotificationType = "INVITATION_RECEIVED"
+	NotificationTypeTaskAssigned       NotificationType = "TASK_ASSIGNED"
+	NotificationTypeTaskStatusChanged  NotificationType = "TASK_STATUS_CHANGED"
+	NotificationTypeTaskApproved       NotificationType = "TASK_APPROVED"
+	NotificationTypeMentioned          NotificationType = "MENTIONED"
+	NotificationTypeSprintStarted      NotificationType = "SPRINT_STARTED"
+	NotificationTypeSprintCompleted    NotificationType = "SPRINT_COMPLETED"
+)
+
+func (n NotificationType) String() string {
+	return string(n)
+}
@@ -0,0 +1,114 @@
+package models
+
+type Permission string
+
+const (
+	// Workspace scoped permissions
+	PermissionWorkspaceView   Permission = "workspace:view"
+	PermissionWorkspaceInvite Permission = "workspace:invite"
+	PermissionProjectCreate   Permission = "project:create"
+
+	// Project scoped permissions
+	PermissionProjectView         Permission = "project:view"
+	PermissionProjectManage       Permission = "project:manage"
+	PermissionSprintView          Permission = "sprint:view"
+	PermissionSprintCreate        Permission = "sprint:create"
+	PermissionSprintEdit          Permission = "sprint:edit"
+	PermissionTaskView            Permission = "task:view"
+	PermissionTaskCreate          Permission = "task:create"
+	PermissionTaskEdit            Permission = "task:edit"
+	PermissionTaskApprove         Permission = "task:approve"
+	PermissionTaskComment         Permission = "task:comment"
+	PermissionTaskCommentModerate Permission = "task:comment:moderate"
+)
+
+type PermissionScope string
+
+const (
+	PermissionScopeWorkspace PermissionScope = "WORKSPACE"
+	PermissionScopeProject   PermissionScope = "PROJECT"
+)
+
+// PermissionRule describes the minimum role required for a permission.
+// Workspace scoped rules are evaluated against WorkspaceRole, project scoped rules against ProjectRole.
+type PermissionRule struct {
+	Scope         PermissionScope
+	WorkspaceRole WorkspaceMemberRole
+	ProjectRole   ProjectMemberRole
+}
+
+var PermissionMatrix = map[Permission]PermissionRule{
+	PermissionWorkspaceView:   {Scope: PermissionScopeWorkspace, WorkspaceRole: WorkspaceMemberRoleMember},
+	PermissionWorkspaceInvite: {Scope: PermissionScopeWorkspace, WorkspaceRole: WorkspaceMemberRoleOwner},
+	PermissionProjectCreate:   {Scope: PermissionScopeWorkspace, WorkspaceRole: WorkspaceMemberRoleModerator},
+
+	PermissionProjectView:   {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionProjectManage: {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleModerator},
+	PermissionSprintView:    {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionSprintCreate:  {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleModerator},
+	PermissionSprintEdit:    {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleModerator},
+	PermissionTaskView:      {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionTaskCreate:    {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionTaskEdit:      {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionTaskApprove:   {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+	PermissionTaskComment:   {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleMember},
+
+	PermissionTaskCommentModerate: {Scope: PermissionScopeProject, ProjectRole: ProjectMemberRoleModerator},
+}
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+func (p Permission) IsValid() bool {
+	_, ok := PermissionMatrix[p]
+	return ok
+}
+
+func (p Permission) Scope() PermissionScope {
+	return PermissionMatrix[p].Scope
+}
+
+// Can reports whether the workspace role satisfies a workspace scoped permission
+func (w WorkspaceMemberRole) Can(permission Permission) bool {
+	rule, ok := PermissionMatrix[permission]
+	if !ok || rule.Scope != PermissionScopeWorkspace {
+		return false
+	}
+
+	return w.rank() >= rule.WorkspaceRole.rank()
+}
+
+// Can reports whether the project role satisfies a project scoped permission
+func (p ProjectMemberRole) Can(permission Permission) bool {
+	rule, ok := PermissionMatrix[permission]
+	if !ok || rule.Scope != PermissionScopeProject {
+		return false
+	}
+
+	return p.rank() >= rule.ProjectRole.rank()
+}
+
+func (w WorkspaceMemberRole) rank() int {
+	switch w {
+	case WorkspaceMemberRoleOwner:
+		return 3
+	case WorkspaceMemberRoleModerator:
+		return 2
+	case WorkspaceMemberRoleMember:
+		return 1
+	}
+	return 0
+}
+
+func (p ProjectMemberRole) rank() int {
+	switch p {
+	case ProjectMemberRoleOwner:
+		return 3
+	case ProjectMemberRoleModerator:
+		return 2
+	case ProjectMemberRoleMember:
+		return 1
+	}
+	return 0
+}
@@ -43,18 +43,64 @@ func (p ProjectStatus) IsValid() bool {
 	return false
 }
 
+// Workflow is one status of the project board. Tasks start in the default status,
+// and tasks in a done status are finished, they are not carried over when a sprint completes.
 type Workflow struct {
-	PreviousStatuses []string `bson:"previous_statuses" json:"previousStatuses"`
-	Status           string   `bson:"status" json:"status"`
-	IsDefault        bool     `bson:"is_default" json:"isDefault"`
+	PreviousStatuses []string       `bson:"previous_statuses" json:"previousStatuses"`
+	Status           string         `bson:"status" json:"status"`
+	IsDefault        bool           `bson:"is_default" json:"isDefault"`
+	IsDone           bool           `bson:"is_done" json:"isDone"`
+	Rules            *WorkflowRules `bson:"rules" json:"rules"`
+}
+
+// WorkflowRules are checked when a task is moved into the workflow's status, every rule that is set must pass
+type WorkflowRules struct {
+	RequiredAttributes []string            `bson:"required_attributes" json:"requiredAttributes"`
+	RequiredApprovals  int                 `bson:"required_approvals" json:"requiredApprovals"`
+	AllowedRoles       []ProjectMemberRole `bson:"allowed_roles" json:"allowedRoles"`
+	AllowedPositions   []string            `bson:"allowed_positions" json:"allowedPositions"`
+	AssigneeOnly       bool                `bson:"assignee_only" json:"assigneeOnly"`
 }
 
 func GetDefaultWorkflows() []Workflow {
 	return []Workflow{
 		{Status: "TODO", IsDefault: true},
 		{Status: "IN_PROGRESS", PreviousStatuses: []string{"TODO"}},
-		{Status: "DONE", PreviousStatuses: []string{"IN_PROGRESS"}},
+		{Status: "DONE", PreviousStatuses: []string{"IN_PROGRESS"}, IsDone: true},
+	}
+}
+
+// GetDoneStatuses returns the statuses marked as done
+func GetDoneStatuses(workflows []Workflow) []string {
+	doneStatuses := make([]string, 0)
+	for _, workflow := range workflows {
+		if workflow.IsDone {
+			doneStatuses = append(doneStatuses, workflow.Status)
+		}
+	}
+	return doneStatuses
+}
+
+// GetTerminalStatuses returns the statuses that no other status can be moved to from,
+// i.e. the statuses that do not appear in any workflow's PreviousStatuses.
+// It is only used to guess the done statuses of workflows created before IsDone existed.
+func GetTerminalStatuses(workflows []Workflow) []string {
+	hasNext := make(map[string]bool, len(workflows))
+	for _, workflow := range workflows {
+		for _, previousStatus := range workflow.PreviousStatuses {
+			if previousStatus != workflow.Status {
+				hasNext[previousStatus] = true
+			}
+		}
+	}
+
+	terminalStatuses := make([]string, 0)
+	for _, workflow := range workflows {
+		if !hasNext[workflow.Status] {
+			terminalStatuses = append(terminalStatuses, workflow.Status)
+		}
 	}
+	return terminalStatuses
 }
 
 type AttributeTemplate struct {
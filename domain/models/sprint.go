@@ -7,14 +7,53 @@ import (
 )
 
 type Sprint struct {
-	ID         bson.ObjectID `bson:"_id" json:"id"`
-	ProjectID  bson.ObjectID `bson:"project_id" json:"projectId"`
-	Title      string        `bson:"title" json:"title"`
-	SprintGoal *string       `bson:"sprint_goal" json:"sprintGoal"`
-	StartDate  *time.Time    `bson:"start_date" json:"startDate"`
-	EndDate    *time.Time    `bson:"end_date" json:"endDate"`
-	CreatedAt  time.Time     `bson:"created_at" json:"createdAt"`
-	CreatedBy  bson.ObjectID `bson:"created_by" json:"createdBy"`
-	UpdatedAt  time.Time     `bson:"updated_at" json:"updatedAt"`
-	UpdatedBy  bson.ObjectID `bson:"updated_by" json:"updatedBy"`
+	ID            bson.ObjectID        `bson:"_id" json:"id"`
+	ProjectID     bson.ObjectID        `bson:"project_id" json:"projectId"`
+	Title         string               `bson:"title" json:"title"`
+	SprintGoal    *string              `bson:"sprint_goal" json:"sprintGoal"`
+	StartDate     *time.Time           `bson:"start_date" json:"startDate"`
+	EndDate       *time.Time           `bson:"end_date" json:"endDate"`
+	Status        SprintStatus         `bson:"status" json:"status"`
+	Retrospective *SprintRetrospective `bson:"retrospective" json:"retrospective"`
+	CreatedAt     time.Time            `bson:"created_at" json:"createdAt"`
+	CreatedBy     bson.ObjectID        `bson:"created_by" json:"createdBy"`
+	UpdatedAt     time.Time            `bson:"updated_at" json:"updatedAt"`
+	UpdatedBy     bson.ObjectID        `bson:"updated_by" json:"updatedBy"`
+}
+
+// SprintRetrospective is the sprint summary kept as a retrospective note
+type SprintRetrospective struct {
+	Summary     string                    `bson:"summary" json:"summary"`
+	Completed   []SprintRetrospectiveTask `bson:"completed" json:"completed"`
+	CarriedOver []SprintRetrospectiveTask `bson:"carried_over" json:"carriedOver"`
+	Blockers    []string                  `bson:"blockers" json:"blockers"`
+	Highlights  []string                  `bson:"highlights" json:"highlights"`
+	CreatedAt   time.Time                 `bson:"created_at" json:"createdAt"`
+	CreatedBy   bson.ObjectID             `bson:"created_by" json:"createdBy"`
+}
+
+type SprintRetrospectiveTask struct {
+	TaskID string `bson:"task_id" json:"taskId"`
+	Title  string `bson:"title" json:"title"`
+	Status string `bson:"status" json:"status"`
+}
+
+type SprintStatus string
+
+const (
+	SprintStatusPlanned   SprintStatus = "PLANNED"
+	SprintStatusActive    SprintStatus = "ACTIVE"
+	SprintStatusCompleted SprintStatus = "COMPLETED"
+)
+
+func (s SprintStatus) String() string {
+	return string(s)
+}
+
+func (s SprintStatus) IsValid() bool {
+	switch s {
+	case SprintStatusPlanned, SprintStatusActive, SprintStatusCompleted:
+		return true
+	}
+	return false
 }
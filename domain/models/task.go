@@ -19,6 +19,8 @@ type Task struct {
 	Approval    []TaskApproval `bson:"approval" json:"approval"`
 	Assignee    []TaskAssignee `bson:"assignee" json:"assignee"`
 	Sprint      *TaskSprint    `bson:"sprint" json:"sprint"`
+	Attributes  []KeyValuePair `bson:"attributes" json:"attributes"`
+	Mentions    []Mention      `bson:"mentions" json:"mentions"`
 	CreatedAt   time.Time      `bson:"created_at" json:"createdAt"`
 	CreatedBy   bson.ObjectID  `bson:"created_by" json:"createdBy"`
 	UpdatedAt   time.Time      `bson:"updated_at" json:"updatedAt"`
@@ -69,8 +71,9 @@ func (t TaskPriority) IsValid() bool {
 }
 
 type TaskApproval struct {
-	Reason string        `bson:"reason" json:"reason"`
-	UserID bson.ObjectID `bson:"user_id" json:"userId"`
+	Reason    string        `bson:"reason" json:"reason"`
+	UserID    bson.ObjectID `bson:"user_id" json:"userId"`
+	CreatedAt time.Time     `bson:"created_at" json:"createdAt"`
 }
 
 type TaskAssignee struct {
@@ -81,5 +84,5 @@ type TaskAssignee struct {
 
 type TaskSprint struct {
 	PreviousSprintIDs []bson.ObjectID `bson:"previous_sprint_ids" json:"previousSprintIds"`
-	CurrentSprintID   bson.ObjectID   `bson:"current_sprint_id" json:"currentSprintId"`
+	CurrentSprintID   *bson.ObjectID  `bson:"current_sprint_id" json:"currentSprintId"`
 }
@@ -7,10 +7,23 @@ import (
 )
 
 type TaskComment struct {
-	ID        bson.ObjectID `bson:"_id" json:"id"`
-	Content   string        `bson:"content" json:"content"`
-	UserID    bson.ObjectID `bson:"user_id" json:"userId"`
-	TaskID    string        `bson:"task_id" json:"taskId"`
-	CreatedAt time.Time     `bson:"created_at" json:"createdAt"`
-	UpdatedAt time.Time     `bson:"updated_at" json:"updatedAt"`
+	ID        bson.ObjectID  `bson:"_id" json:"id"`
+	Content   string         `bson:"content" json:"content"`
+	UserID    bson.ObjectID  `bson:"user_id" json:"userId"`
+	TaskID    string         `bson:"task_id" json:"taskId"`
+	ParentID  *bson.ObjectID `bson:"parent_id" json:"parentId"`
+	Mentions  []Mention      `bson:"mentions" json:"mentions"`
+	CreatedAt time.Time      `bson:"created_at" json:"createdAt"`
+	UpdatedAt time.Time      `bson:"updated_at" json:"updatedAt"`
+	EditedAt  *time.Time     `bson:"edited_at" json:"editedAt"`
+	DeletedAt *time.Time     `bson:"deleted_at" json:"deletedAt"`
+	DeletedBy *bson.ObjectID `bson:"deleted_by" json:"deletedBy"`
+}
+
+func (t *TaskComment) IsEdited() bool {
+	return t.EditedAt != nil
+}
+
+func (t *TaskComment) IsDeleted() bool {
+	return t.DeletedAt != nil
 }
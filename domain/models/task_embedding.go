@@ -0,0 +1,14 @@
+package models
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// TaskEmbedding is the part of a task used for similarity search, the vector is stored on the task document
+type TaskEmbedding struct {
+	ID             bson.ObjectID `bson:"_id"`
+	TaskID         string        `bson:"task_id"`
+	Title          string        `bson:"title"`
+	Type           TaskType      `bson:"type"`
+	Status         string        `bson:"status"`
+	Embedding      []float32     `bson:"embedding"`
+	EmbeddingModel string        `bson:"embedding_model"`
+}
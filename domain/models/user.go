@@ -24,5 +24,13 @@ type UserCustomClaims struct {
 	DisplayName string `json:"displayName"`
 	Email       string `json:"email"`
 	ProfileUrl  string `json:"profileUrl"`
+	FamilyID    string `json:"fid"`
+	jwt.RegisteredClaims
+}
+
+// RefreshTokenClaims are signed with the refresh token secret. Every token rotated from the same
+// login shares a FamilyID so the whole chain can be revoked at once.
+type RefreshTokenClaims struct {
+	FamilyID string `json:"fid"`
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type Webhook struct {
+	ID        bson.ObjectID  `bson:"_id" json:"id"`
+	ProjectID bson.ObjectID  `bson:"project_id" json:"projectId"`
+	URL       string         `bson:"url" json:"url"`
+	Secret    string         `bson:"secret" json:"-"`
+	Events    []WebhookEvent `bson:"events" json:"events"`
+	CreatedAt time.Time      `bson:"created_at" json:"createdAt"`
+	CreatedBy bson.ObjectID  `bson:"created_by" json:"createdBy"`
+}
+
+func (w *Webhook) IsSubscribed(event WebhookEvent) bool {
+	for _, subscribedEvent := range w.Events {
+		if subscribedEvent == event {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookEvent string
+
+const (
+	WebhookEventTaskCreated       WebhookEvent = "task.created"
+	WebhookEventTaskUpdated       WebhookEvent = "task.updated"
+	WebhookEventTaskStatusChanged WebhookEvent = "task.status_changed"
+	WebhookEventCommentCreated    WebhookEvent = "comment.created"
+	WebhookEventSprintStarted     WebhookEvent = "sprint.started"
+	WebhookEventSprintCompleted   WebhookEvent = "sprint.completed"
+)
+
+func (w WebhookEvent) String() string {
+	return string(w)
+}
+
+func (w WebhookEvent) IsValid() bool {
+	switch w {
+	case WebhookEventTaskCreated, WebhookEventTaskUpdated, WebhookEventTaskStatusChanged,
+		WebhookEventCommentCreated, WebhookEventSprintStarted, WebhookEventSprintCompleted:
+		return true
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	ID             bson.ObjectID         `bson:"_id" json:"id"`
+	WebhookID      bson.ObjectID         `bson:"webhook_id" json:"webhookId"`
+	ProjectID      bson.ObjectID         `bson:"project_id" json:"projectId"`
+	Event          WebhookEvent          `bson:"event" json:"event"`
+	Payload        string                `bson:"payload" json:"payload"`
+	Status         WebhookDeliveryStatus `bson:"status" json:"status"`
+	Attempts       int                   `bson:"attempts" json:"attempts"`
+	NextAttemptAt  *time.Time            `bson:"next_attempt_at" json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time            `bson:"last_attempt_at" json:"lastAttemptAt"`
+	ResponseStatus *int                  `bson:"response_status" json:"responseStatus"`
+	LastError      *string               `bson:"last_error" json:"lastError"`
+	CreatedAt      time.Time             `bson:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time             `bson:"updated_at" json:"updatedAt"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+func (w WebhookDeliveryStatus) String() string {
+	return string(w)
+}
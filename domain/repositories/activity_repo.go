@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type ActivityRepository interface {
+	Create(ctx context.Context, in *CreateActivityRequest) error
+	CreateMany(ctx context.Context, in []*CreateActivityRequest) error
+	Search(ctx context.Context, in *SearchActivityRequest) ([]*models.Activity, int64, error)
+	// RedactChanges clears the old and new values of a field in every activity of the entity
+	RedactChanges(ctx context.Context, in *RedactActivityChangesRequest) error
+}
+
+type CreateActivityRequest struct {
+	ProjectID  bson.ObjectID
+	TaskID     *string
+	EntityType models.ActivityEntityType
+	EntityID   string
+	Action     models.ActivityAction
+	Changes    []models.ActivityChange
+	CreatedBy  bson.ObjectID
+}
+
+type SearchActivityRequest struct {
+	ProjectID         bson.ObjectID
+	TaskID            *string
+	EntityType        models.ActivityEntityType
+	PaginationRequest PaginationRequest
+}
+
+type RedactActivityChangesRequest struct {
+	EntityType models.ActivityEntityType
+	EntityID   string
+	Field      string
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// BoardEventHub delivers board events to every subscriber of a project, across all replicas
+type BoardEventHub interface {
+	Publish(ctx context.Context, event *models.BoardEvent) error
+	Subscribe(ctx context.Context, projectID bson.ObjectID) (<-chan *models.BoardEvent, func(), error)
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "context"
+
+// EmbeddingRepository turns text into vectors that can be compared by cosine similarity.
+// Vectors from different models are not comparable, so callers store Model next to every vector.
+type EmbeddingRepository interface {
+	Configured() bool
+	Model() string
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
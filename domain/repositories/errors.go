@@ -0,0 +1,12 @@
+package repositories
+
+import "errors"
+
+// ErrDuplicateKey is returned when a write is rejected by a unique index
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ErrWriteConflict is returned when a conditional write finds the document changed since it was read
+var ErrWriteConflict = errors.New("document was changed since it was read")
+
+// ErrAmbiguousTaskID is returned when a task ID belongs to tasks of more than one project
+var ErrAmbiguousTaskID = errors.New("task ID matches tasks of more than one project")
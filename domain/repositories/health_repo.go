@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// HealthChecker probes one dependency, Check returns an error when the dependency can not serve requests
+type HealthChecker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+)
+
+// LLMChunkHandler receives each piece of a streamed response, returning an error stops the stream
+type LLMChunkHandler func(chunk string) error
+
+// LLMRepository talks to a language model provider.
+// Requests without a model use the default model of the config.
+type LLMRepository interface {
+	// Configured reports whether the provider has somewhere to send requests, requests fail when it does not
+	Configured() bool
+	Complete(ctx context.Context, in *LLMCompletionRequest) (string, error)
+	CompleteStream(ctx context.Context, in *LLMCompletionRequest, onChunk LLMChunkHandler) error
+	Chat(ctx context.Context, in *LLMChatRequest) (*models.LLMMessage, error)
+	ChatStream(ctx context.Context, in *LLMChatRequest, onChunk LLMChunkHandler) error
+	Embed(ctx context.Context, in *LLMEmbedRequest) ([][]float32, error)
+}
+
+// LLMCompletionRequest asks for the model to answer with a JSON object when JSON is set
+type LLMCompletionRequest struct {
+	Model  string
+	System string
+	Prompt string
+	JSON   bool
+}
+
+type LLMChatRequest struct {
+	Model    string
+	Messages []models.LLMMessage
+	JSON     bool
+}
+
+// LLMEmbedRequest returns one embedding per input, in the same order
+type LLMEmbedRequest struct {
+	Model string
+	Input []string
+}
@@ -0,0 +1,8 @@
+package repositories
+
+import "context"
+
+// MigrationRepository brings indexes and existing data up to date before the app starts serving
+type MigrationRepository interface {
+	Migrate(ctx context.Context) error
+}
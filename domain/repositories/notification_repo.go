@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type NotificationRepository interface {
+	CreateMany(ctx context.Context, in []*CreateNotificationRequest) ([]*models.Notification, error)
+	Search(ctx context.Context, in *SearchNotificationRequest) ([]*models.Notification, int64, error)
+	CountUnread(ctx context.Context, userID bson.ObjectID) (int64, error)
+	MarkAsRead(ctx context.Context, id bson.ObjectID, userID bson.ObjectID) (*models.Notification, error)
+	MarkAllAsRead(ctx context.Context, userID bson.ObjectID) (int64, error)
+}
+
+type CreateNotificationRequest struct {
+	UserID      bson.ObjectID
+	Type        models.NotificationType
+	Message     string
+	WorkspaceID *bson.ObjectID
+	ProjectID   *bson.ObjectID
+	TaskID      *string
+	SprintID    *bson.ObjectID
+	ActorID     bson.ObjectID
+}
+
+type SearchNotificationRequest struct {
+	UserID            bson.ObjectID
+	IsUnread          bool
+	PaginationRequest PaginationRequest
+}
+
+// NotificationPublisher pushes notifications that were already stored to users that are currently connected
+type NotificationPublisher interface {
+	Publish(ctx context.Context, notification *models.Notification) error
+	Subscribe(userID bson.ObjectID) (<-chan *models.Notification, func())
+}
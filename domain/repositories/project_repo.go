@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -10,15 +11,21 @@ import (
 type ProjectRepository interface {
 	FindByProjectID(ctx context.Context, projectID bson.ObjectID) (*models.Project, error)
 	FindByWorkspaceIDAndName(ctx context.Context, workspaceID bson.ObjectID, name string) (*models.Project, error)
-	FindByWorkspaceIDAndProjectPrefix(ctx context.Context, workspaceID bson.ObjectID, projectPrefix string) (*models.Project, error)
+	// FindByProjectPrefix looks across workspaces, tasks are found by their task ID alone so prefixes are unique globally
+	FindByProjectPrefix(ctx context.Context, projectPrefix string) (*models.Project, error)
+	// Create returns ErrDuplicateKey when the project prefix is already taken
 	Create(ctx context.Context, project *CreateProjectRequest) (*models.Project, error)
 	FindByProjectIDsAndWorkspaceID(ctx context.Context, projectIDs []bson.ObjectID, workspaceID bson.ObjectID) ([]*models.Project, error)
 	AddPositions(ctx context.Context, projectID bson.ObjectID, position []string) error
 	FindPositionByProjectID(ctx context.Context, projectID bson.ObjectID) ([]string, error)
-	AddWorkflows(ctx context.Context, projectID bson.ObjectID, workflows []models.Workflow) error
 	FindWorkflowByProjectID(ctx context.Context, projectID bson.ObjectID) ([]models.Workflow, error)
-	IncrementSprintRunningNumber(ctx context.Context, projectID bson.ObjectID) error
-	IncrementTaskRunningNumber(ctx context.Context, projectID bson.ObjectID) error
+	// UpdateWorkflows replaces the whole workflow list, the order of the list is the order shown on the board.
+	// It returns ErrWriteConflict when the project was updated after UpdatedAt.
+	UpdateWorkflows(ctx context.Context, in *UpdateProjectWorkflowsRequest) error
+	// NextSprintRunningNumber atomically increments the sprint running number and returns the number reserved for the caller
+	NextSprintRunningNumber(ctx context.Context, projectID bson.ObjectID) (int, error)
+	// NextTaskRunningNumber atomically increments the task running number and returns the number reserved for the caller
+	NextTaskRunningNumber(ctx context.Context, projectID bson.ObjectID) (int, error)
 	AddAttributeTemplates(ctx context.Context, projectID bson.ObjectID, attributeTemplates []models.AttributeTemplate) error
 	FindAttributeTemplatesByProjectID(ctx context.Context, projectID bson.ObjectID) ([]models.AttributeTemplate, error)
 }
@@ -33,3 +40,11 @@ type CreateProjectRequest struct {
 	Workflows     []models.Workflow
 	CreatedBy     bson.ObjectID
 }
+
+type UpdateProjectWorkflowsRequest struct {
+	ProjectID bson.ObjectID
+	Workflows []models.Workflow
+	UpdatedBy bson.ObjectID
+	// UpdatedAt is the updated_at of the project the workflows were read from
+	UpdatedAt time.Time
+}
@@ -12,6 +12,11 @@ type SprintRepository interface {
 	Create(ctx context.Context, sprint *CreateSprintRequest) (*models.Sprint, error)
 	FindByID(ctx context.Context, sprintID bson.ObjectID) (*models.Sprint, error)
 	Update(ctx context.Context, sprint *UpdateSprintRequest) error
+	FindByProjectIDAndStatus(ctx context.Context, projectID bson.ObjectID, status models.SprintStatus) ([]*models.Sprint, error)
+	// UpdateStatus returns ErrDuplicateKey when the project already has an active sprint
+	UpdateStatus(ctx context.Context, in *UpdateSprintStatusRequest) error
+	Search(ctx context.Context, in *SearchSprintRequest) ([]*models.Sprint, int64, error)
+	UpdateRetrospective(ctx context.Context, in *UpdateSprintRetrospectiveRequest) error
 }
 
 type CreateSprintRequest struct {
@@ -28,3 +33,25 @@ type UpdateSprintRequest struct {
 	EndDate    *time.Time
 	UpdatedBy  bson.ObjectID
 }
+
+type UpdateSprintStatusRequest struct {
+	ID        bson.ObjectID
+	Status    models.SprintStatus
+	StartDate *time.Time
+	EndDate   *time.Time
+	UpdatedBy bson.ObjectID
+}
+
+type SearchSprintRequest struct {
+	ProjectID         bson.ObjectID
+	Status            models.SprintStatus
+	StartDateFrom     *time.Time
+	EndDateTo         *time.Time
+	PaginationRequest PaginationRequest
+}
+
+type UpdateSprintRetrospectiveRequest struct {
+	ID            bson.ObjectID
+	Retrospective *models.SprintRetrospective
+	UpdatedBy     bson.ObjectID
+}
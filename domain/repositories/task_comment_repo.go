@@ -9,11 +9,28 @@ import (
 
 type TaskCommentRepository interface {
 	Create(ctx context.Context, taskComment *CreateTaskCommentRequest) (*models.TaskComment, error)
+	FindByID(ctx context.Context, id bson.ObjectID) (*models.TaskComment, error)
 	FindByTaskID(ctx context.Context, taskID string) ([]*models.TaskComment, error)
+	FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*models.TaskComment, error)
+	UpdateContent(ctx context.Context, in *UpdateTaskCommentContentRequest) (*models.TaskComment, error)
+	SoftDelete(ctx context.Context, in *SoftDeleteTaskCommentRequest) error
 }
 
 type CreateTaskCommentRequest struct {
-	TaskID  string
-	Content string
-	UserID  bson.ObjectID
+	TaskID   string
+	Content  string
+	UserID   bson.ObjectID
+	ParentID *bson.ObjectID
+	Mentions []models.Mention
+}
+
+type UpdateTaskCommentContentRequest struct {
+	ID       bson.ObjectID
+	Content  string
+	Mentions []models.Mention
+}
+
+type SoftDeleteTaskCommentRequest struct {
+	ID        bson.ObjectID
+	DeletedBy bson.ObjectID
 }
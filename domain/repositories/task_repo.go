@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -10,7 +11,26 @@ import (
 type TaskRepository interface {
 	Create(ctx context.Context, task *CreateTaskRequest) (*models.Task, error)
 	FindByID(ctx context.Context, id bson.ObjectID) (*models.Task, error)
+	// FindByTaskID returns ErrAmbiguousTaskID when projects created before prefixes were unique share the task ID
 	FindByTaskID(ctx context.Context, taskID string) (*models.Task, error)
+	FindByProjectIDAndTaskIDs(ctx context.Context, projectID bson.ObjectID, taskIDs []string) ([]*models.Task, error)
+	FindBySprintID(ctx context.Context, projectID bson.ObjectID, sprintID bson.ObjectID, excludeStatuses []string) ([]*models.Task, error)
+	FindBySprintHistory(ctx context.Context, projectID bson.ObjectID, sprintID bson.ObjectID) ([]*models.Task, error)
+	Search(ctx context.Context, in *SearchTaskRequest) ([]*models.Task, int64, error)
+	UpdateDetail(ctx context.Context, in *UpdateTaskDetailRequest) (*models.Task, error)
+	// UpdateStatus moves the task from FromStatus to Status and clears its approvals, which were given for the previous status.
+	// It returns ErrWriteConflict when the task is no longer in FromStatus.
+	UpdateStatus(ctx context.Context, in *UpdateTaskStatusRequest) (*models.Task, error)
+	UpdateAssignees(ctx context.Context, in *UpdateTaskAssigneesRequest) (*models.Task, error)
+	UpdateApprovals(ctx context.Context, in *UpdateTaskApprovalsRequest) (*models.Task, error)
+	CarryOverSprintTasks(ctx context.Context, in *CarryOverSprintTasksRequest) (int64, error)
+	UpdateSprint(ctx context.Context, in *UpdateTasksSprintRequest) (int64, error)
+	CountByStatus(ctx context.Context, projectID bson.ObjectID, status string) (int64, error)
+	// ReplaceStatus moves every task of the project in FromStatus to ToStatus and returns the number of tasks moved
+	ReplaceStatus(ctx context.Context, in *ReplaceTaskStatusRequest) (int64, error)
+	UpdateEmbedding(ctx context.Context, in *UpdateTaskEmbeddingRequest) error
+	FindEmbeddingsByProjectID(ctx context.Context, projectID bson.ObjectID, model string) ([]*models.TaskEmbedding, error)
+	FindWithoutEmbedding(ctx context.Context, projectID bson.ObjectID, model string, limit int) ([]*models.Task, error)
 }
 
 type CreateTaskRequest struct {
@@ -22,5 +42,84 @@ type CreateTaskRequest struct {
 	Type        models.TaskType
 	Status      string
 	Sprint      *models.TaskSprint
+	Attributes  []models.KeyValuePair
+	Mentions    []models.Mention
 	CreatedBy   bson.ObjectID
 }
+
+type UpdateTaskDetailRequest struct {
+	ID          bson.ObjectID
+	Title       string
+	Description *string
+	Priority    *models.TaskPriority
+	Attributes  []models.KeyValuePair
+	Mentions    []models.Mention
+	UpdatedBy   bson.ObjectID
+}
+
+type UpdateTaskStatusRequest struct {
+	ID         bson.ObjectID
+	FromStatus string
+	Status     string
+	UpdatedBy  bson.ObjectID
+}
+
+type SearchTaskRequest struct {
+	ProjectID         bson.ObjectID
+	Status            string
+	Type              models.TaskType
+	Priority          models.TaskPriority
+	AssigneeID        *bson.ObjectID
+	SprintID          *bson.ObjectID
+	IsBacklog         bool
+	ParentID          string
+	Keyword           string
+	AttributeKey      string
+	AttributeValue    interface{}
+	PaginationRequest PaginationRequest
+}
+
+type UpdateTaskAssigneesRequest struct {
+	ID        bson.ObjectID
+	Assignees []models.TaskAssignee
+	UpdatedBy bson.ObjectID
+}
+
+type UpdateTaskApprovalsRequest struct {
+	ID        bson.ObjectID
+	Approvals []models.TaskApproval
+	UpdatedBy bson.ObjectID
+}
+
+type CarryOverSprintTasksRequest struct {
+	ProjectID       bson.ObjectID
+	FromSprintID    bson.ObjectID
+	ToSprintID      *bson.ObjectID
+	ExcludeStatuses []string
+	UpdatedBy       bson.ObjectID
+}
+
+type UpdateTasksSprintRequest struct {
+	ProjectID bson.ObjectID
+	TaskIDs   []string
+	SprintID  *bson.ObjectID
+	UpdatedBy bson.ObjectID
+}
+
+type ReplaceTaskStatusRequest struct {
+	ProjectID  bson.ObjectID
+	FromStatus string
+	ToStatus   string
+	// ClearApprovals is set when the tasks move to another status, a renamed status keeps the approvals given for it
+	ClearApprovals bool
+	UpdatedBy      bson.ObjectID
+}
+
+// UpdateTaskEmbeddingRequest is skipped when the task was updated after UpdatedAt,
+// so an embedding of an older version never replaces the one of a newer version
+type UpdateTaskEmbeddingRequest struct {
+	ID        bson.ObjectID
+	Embedding []float32
+	Model     string
+	UpdatedAt time.Time
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+type TokenRepository interface {
+	// RevokeToken denylists a token ID for ttl. It returns false if the token was already revoked.
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) (bool, error)
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	RevokeTokenFamily(ctx context.Context, familyID string, ttl time.Duration) error
+	IsTokenFamilyRevoked(ctx context.Context, familyID string) (bool, error)
+}
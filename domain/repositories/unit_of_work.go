@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// UnitOfWork runs several repository calls as one atomic operation.
+// Repositories join the transaction when they are called with the context passed to fn.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
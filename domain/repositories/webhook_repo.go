@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, in *CreateWebhookRequest) (*models.Webhook, error)
+	FindByID(ctx context.Context, id bson.ObjectID) (*models.Webhook, error)
+	FindByProjectID(ctx context.Context, projectID bson.ObjectID) ([]*models.Webhook, error)
+	FindByProjectIDAndEvent(ctx context.Context, projectID bson.ObjectID, event models.WebhookEvent) ([]*models.Webhook, error)
+	Delete(ctx context.Context, id bson.ObjectID) error
+}
+
+type CreateWebhookRequest struct {
+	ProjectID bson.ObjectID
+	URL       string
+	Secret    string
+	Events    []models.WebhookEvent
+	CreatedBy bson.ObjectID
+}
+
+type WebhookDeliveryRepository interface {
+	CreateMany(ctx context.Context, in []*CreateWebhookDeliveryRequest) error
+	FindByID(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error)
+	Search(ctx context.Context, in *SearchWebhookDeliveryRequest) ([]*models.WebhookDelivery, int64, error)
+	ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time) (*models.WebhookDelivery, error)
+	UpdateAttempt(ctx context.Context, in *UpdateWebhookDeliveryAttemptRequest) error
+	Reset(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error)
+}
+
+type CreateWebhookDeliveryRequest struct {
+	WebhookID bson.ObjectID
+	ProjectID bson.ObjectID
+	Event     models.WebhookEvent
+	Payload   string
+}
+
+type SearchWebhookDeliveryRequest struct {
+	WebhookID         bson.ObjectID
+	Status            models.WebhookDeliveryStatus
+	PaginationRequest PaginationRequest
+}
+
+type UpdateWebhookDeliveryAttemptRequest struct {
+	ID             bson.ObjectID
+	Status         models.WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	ResponseStatus *int
+	LastError      *string
+}
+
+// WebhookSender posts a signed payload to a webhook URL and returns the response status code
+type WebhookSender interface {
+	// CheckURL resolves the URL's host and returns an error if the sender is not allowed to reach it
+	CheckURL(ctx context.Context, rawURL string) error
+	Send(ctx context.Context, in *SendWebhookRequest) (int, error)
+}
+
+type SendWebhookRequest struct {
+	URL        string
+	Secret     string
+	Event      models.WebhookEvent
+	DeliveryID bson.ObjectID
+	Payload    []byte
+}
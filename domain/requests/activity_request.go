@@ -0,0 +1,12 @@
+package requests
+
+type ListTaskActivitiesRequest struct {
+	TaskID string `param:"taskId" validate:"required"`
+	PaginationRequest
+}
+
+type ListProjectActivitiesRequest struct {
+	ProjectID  string `param:"projectId" validate:"required"`
+	EntityType string `query:"entityType"`
+	PaginationRequest
+}
@@ -0,0 +1,21 @@
+package requests
+
+type BreakdownTaskRequest struct {
+	TaskID       string                     `param:"taskId" validate:"required"`
+	Instructions *string                    `json:"instructions"`
+	Create       bool                       `json:"create"`
+	Tasks        []BreakdownTaskRequestTask `json:"tasks" validate:"dive"`
+}
+
+type BreakdownTaskRequestTask struct {
+	Title       string  `json:"title" validate:"required"`
+	Description *string `json:"description"`
+	Type        string  `json:"type" validate:"required"`
+}
+
+type SummarizeSprintRequest struct {
+	ProjectID    string  `param:"projectId" validate:"required"`
+	SprintID     string  `param:"sprintId" validate:"required"`
+	Instructions *string `json:"instructions"`
+	Save         bool    `json:"save"`
+}
@@ -0,0 +1,5 @@
+package requests
+
+type StreamBoardEventsRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+}
@@ -0,0 +1,10 @@
+package requests
+
+type ListNotificationsRequest struct {
+	IsUnread bool `query:"isUnread"`
+	PaginationRequest
+}
+
+type MarkNotificationAsReadRequest struct {
+	NotificationID string `param:"notificationId" validate:"required"`
+}
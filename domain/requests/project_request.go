@@ -47,14 +47,53 @@ type AddWorkflowsRequest struct {
 }
 
 type AddWorkflowsRequestWorkflow struct {
-	PreviousStatuses []string `json:"previousStatuses"`
-	Status           string   `json:"status" validate:"required"`
+	PreviousStatuses []string              `json:"previousStatuses"`
+	Status           string                `json:"status" validate:"required"`
+	IsDone           bool                  `json:"isDone"`
+	Rules            *WorkflowRulesRequest `json:"rules"`
+}
+
+type WorkflowRulesRequest struct {
+	RequiredAttributes []string `json:"requiredAttributes"`
+	RequiredApprovals  int      `json:"requiredApprovals" validate:"min=0"`
+	AllowedRoles       []string `json:"allowedRoles" validate:"dive,oneof=OWNER MODERATOR MEMBER"`
+	AllowedPositions   []string `json:"allowedPositions"`
+	AssigneeOnly       bool     `json:"assigneeOnly"`
 }
 
 type ListWorkflowsPathParams struct {
 	ProjectID string `param:"projectId" validate:"required"`
 }
 
+type UpdateWorkflowRequest struct {
+	ProjectID     string `param:"projectId" validate:"required"`
+	CurrentStatus string `param:"status" validate:"required"`
+	// Status renames the workflow, tasks in the current status are moved along
+	Status string `json:"status" validate:"required"`
+	// PreviousStatuses and IsDone are kept as they are when omitted
+	PreviousStatuses *[]string `json:"previousStatuses"`
+	IsDone           *bool     `json:"isDone"`
+	// Rules is kept as it is when omitted, an empty object removes every rule
+	Rules *WorkflowRulesRequest `json:"rules"`
+}
+
+type DeleteWorkflowRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+	Status    string `param:"status" validate:"required"`
+	// TargetStatus receives the tasks still in the deleted status, it is required when there are any
+	TargetStatus string `query:"targetStatus"`
+}
+
+type SetDefaultWorkflowRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+	Status    string `param:"status" validate:"required"`
+}
+
+type ReorderWorkflowsRequest struct {
+	ProjectID string   `param:"projectId" validate:"required"`
+	Statuses  []string `json:"statuses" validate:"required"`
+}
+
 type AddAttributeTemplatesRequest struct {
 	ProjectID          string                                  `param:"projectId" validate:"required"`
 	AttributeTemplates []AddAttributeTemplatesRequestAttribute `json:"attributesTemplates" validate:"required,dive"`
@@ -7,7 +7,8 @@ type CreateSprintRequest struct {
 }
 
 type GetSprintByIDRequest struct {
-	SprintID string `param:"sprintId" validate:"required"`
+	ProjectID string `param:"projectId" validate:"required"`
+	SprintID  string `param:"sprintId" validate:"required"`
 }
 
 type EditSprintRequest struct {
@@ -19,3 +20,28 @@ type EditSprintRequest struct {
 	StartDate  *time.Time `json:"startDate"`
 	EndDate    *time.Time `json:"endDate"`
 }
+
+type StartSprintRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+	SprintID  string `param:"sprintId" validate:"required"`
+}
+
+type CompleteSprintRequest struct {
+	ProjectID    string  `param:"projectId" validate:"required"`
+	SprintID     string  `param:"sprintId" validate:"required"`
+	NextSprintID *string `json:"nextSprintId"`
+}
+
+type ListSprintsRequest struct {
+	ProjectID     string `param:"projectId" validate:"required"`
+	Status        string `query:"status"`
+	StartDateFrom string `query:"startDateFrom"`
+	EndDateTo     string `query:"endDateTo"`
+	PaginationRequest
+}
+
+type MoveTasksToSprintRequest struct {
+	ProjectID string   `param:"projectId" validate:"required"`
+	TaskIDs   []string `json:"taskIds" validate:"required,min=1"`
+	SprintID  *string  `json:"sprintId"`
+}
@@ -1,6 +1,18 @@
 package requests
 
 type CreateTaskCommentRequest struct {
-	TaskID  string `param:"taskId" validate:"required"`
-	Content string `json:"content" validate:"required"`
+	TaskID   string  `param:"taskId" validate:"required"`
+	Content  string  `json:"content" validate:"required"`
+	ParentID *string `json:"parentId"`
+}
+
+type UpdateTaskCommentRequest struct {
+	TaskID    string `param:"taskId" validate:"required"`
+	CommentID string `param:"commentId" validate:"required"`
+	Content   string `json:"content" validate:"required"`
+}
+
+type DeleteTaskCommentRequest struct {
+	TaskID    string `param:"taskId" validate:"required"`
+	CommentID string `param:"commentId" validate:"required"`
 }
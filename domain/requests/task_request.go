@@ -1,14 +1,85 @@
 package requests
 
 type CreateTaskRequest struct {
-	ProjectID   string  `json:"projectId" validate:"required"`
-	Title       string  `json:"title" validate:"required"`
-	Description *string `json:"description"`
-	ParentID    *string `json:"parentId"`
-	Type        string  `json:"type" validate:"required"`
-	SprintID    *string `json:"sprintId"`
+	ProjectID   string                 `json:"projectId" validate:"required"`
+	Title       string                 `json:"title" validate:"required"`
+	Description *string                `json:"description"`
+	ParentID    *string                `json:"parentId"`
+	Type        string                 `json:"type" validate:"required"`
+	SprintID    *string                `json:"sprintId"`
+	Attributes  []TaskAttributeRequest `json:"attributes" validate:"dive"`
+
+	// CheckDuplicates rejects the task when a similar one already exists in the project
+	CheckDuplicates bool `json:"checkDuplicates"`
+}
+
+type TaskAttributeRequest struct {
+	Key   string      `json:"key" validate:"required"`
+	Value interface{} `json:"value"`
 }
 
 type GetTaskDetailPathParam struct {
 	TaskID string `param:"taskId" validate:"required"`
 }
+
+type ListTasksRequest struct {
+	ProjectID      string `param:"projectId" validate:"required"`
+	Status         string `query:"status"`
+	Type           string `query:"type"`
+	Priority       string `query:"priority"`
+	AssigneeID     string `query:"assigneeId"`
+	SprintID       string `query:"sprintId"`
+	ParentID       string `query:"parentId"`
+	Keyword        string `query:"keyword"`
+	AttributeKey   string `query:"attributeKey"`
+	AttributeValue string `query:"attributeValue"`
+	PaginationRequest
+}
+
+type UpdateTaskDetailRequest struct {
+	TaskID      string                 `param:"taskId" validate:"required"`
+	Title       *string                `json:"title" validate:"omitempty,min=1"`
+	Description *string                `json:"description"`
+	Priority    *string                `json:"priority"`
+	Attributes  []TaskAttributeRequest `json:"attributes" validate:"dive"`
+}
+
+type UpdateTaskStatusRequest struct {
+	TaskID string `param:"taskId" validate:"required"`
+	Status string `json:"status" validate:"required"`
+}
+
+type AddTaskAssigneesRequest struct {
+	TaskID    string                `param:"taskId" validate:"required"`
+	Assignees []TaskAssigneeRequest `json:"assignees" validate:"required,min=1,dive"`
+}
+
+type ApproveTaskRequest struct {
+	TaskID string `param:"taskId" validate:"required"`
+	Reason string `json:"reason" validate:"required"`
+}
+
+type ReplaceTaskAssigneesRequest struct {
+	TaskID    string                `param:"taskId" validate:"required"`
+	Assignees []TaskAssigneeRequest `json:"assignees" validate:"dive"`
+}
+
+type TaskAssigneeRequest struct {
+	Role   string `json:"role" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+	Point  int    `json:"point" validate:"min=0"`
+}
+
+type RemoveTaskAssigneeRequest struct {
+	TaskID string `param:"taskId" validate:"required"`
+	Role   string `query:"role" validate:"required"`
+	UserID string `query:"userId" validate:"required"`
+}
+
+type ListSimilarTasksRequest struct {
+	ProjectID     string  `param:"projectId" validate:"required"`
+	Text          string  `query:"text" validate:"required"`
+	ExcludeTaskID string  `query:"excludeTaskId"`
+	MinScore      float64 `query:"minScore" validate:"min=0,max=1"`
+	Limit         int     `query:"limit" validate:"min=0"`
+}
@@ -11,6 +11,10 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
 type SearchUserParams struct {
 	Keyword           string             `query:"keyword"`
 	PaginationRequest 
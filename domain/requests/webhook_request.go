@@ -0,0 +1,29 @@
+package requests
+
+type CreateWebhookRequest struct {
+	ProjectID string   `param:"projectId" validate:"required"`
+	URL       string   `json:"url" validate:"required"`
+	Events    []string `json:"events" validate:"required,min=1"`
+}
+
+type ListWebhooksRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+}
+
+type DeleteWebhookRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+	WebhookID string `param:"webhookId" validate:"required"`
+}
+
+type ListWebhookDeliveriesRequest struct {
+	ProjectID string `param:"projectId" validate:"required"`
+	WebhookID string `param:"webhookId" validate:"required"`
+	Status    string `query:"status"`
+	PaginationRequest
+}
+
+type RedeliverWebhookRequest struct {
+	ProjectID  string `param:"projectId" validate:"required"`
+	WebhookID  string `param:"webhookId" validate:"required"`
+	DeliveryID string `param:"deliveryId" validate:"required"`
+}
@@ -0,0 +1,8 @@
+package responses
+
+import "github.com/cnc-csku/task-nexus/task-management/domain/models"
+
+type ListActivitiesResponse struct {
+	Activities         []*models.Activity `json:"activities"`
+	PaginationResponse PaginationResponse `json:"paginationResponse"`
+}
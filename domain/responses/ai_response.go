@@ -0,0 +1,26 @@
+package responses
+
+import "github.com/cnc-csku/task-nexus/task-management/domain/models"
+
+type BreakdownTaskResponse struct {
+	ParentTaskID string                          `json:"parentTaskId"`
+	Proposals    []BreakdownTaskResponseProposal `json:"proposals"`
+	CreatedTasks []*models.Task                  `json:"createdTasks"`
+}
+
+type BreakdownTaskResponseProposal struct {
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	Type        models.TaskType `json:"type"`
+}
+
+type SummarizeSprintResponse struct {
+	SprintID      string                      `json:"sprintId"`
+	Retrospective *models.SprintRetrospective `json:"retrospective"`
+	Saved         bool                        `json:"saved"`
+}
+
+// AIStreamTokenResponse carries a piece of the model output while it is generated
+type AIStreamTokenResponse struct {
+	Content string `json:"content"`
+}
@@ -0,0 +1,20 @@
+package responses
+
+import "github.com/cnc-csku/task-nexus/task-management/domain/models"
+
+type HealthLivenessResponse struct {
+	Status models.HealthStatus `json:"status"`
+}
+
+type HealthReadinessResponse struct {
+	Status   models.HealthStatus            `json:"status"`
+	Draining bool                           `json:"draining"`
+	Checks   []HealthReadinessResponseCheck `json:"checks"`
+}
+
+type HealthReadinessResponseCheck struct {
+	Name      string              `json:"name"`
+	Status    models.HealthStatus `json:"status"`
+	LatencyMs int64               `json:"latencyMs"`
+	Error     *string             `json:"error,omitempty"`
+}
@@ -0,0 +1,14 @@
+package responses
+
+import "github.com/cnc-csku/task-nexus/task-management/domain/models"
+
+type ListNotificationsResponse struct {
+	Notifications      []*models.Notification `json:"notifications"`
+	UnreadCount        int64                  `json:"unreadCount"`
+	PaginationResponse PaginationResponse     `json:"paginationResponse"`
+}
+
+type MarkAllNotificationsAsReadResponse struct {
+	Message      string `json:"message"`
+	UpdatedCount int64  `json:"updatedCount"`
+}
@@ -75,6 +75,24 @@ type AddWorkflowsResponse struct {
 	Message string `json:"message"`
 }
 
+type UpdateWorkflowResponse struct {
+	Message          string `json:"message"`
+	UpdatedTaskCount int64  `json:"updatedTaskCount"`
+}
+
+type DeleteWorkflowResponse struct {
+	Message        string `json:"message"`
+	MovedTaskCount int64  `json:"movedTaskCount"`
+}
+
+type SetDefaultWorkflowResponse struct {
+	Message string `json:"message"`
+}
+
+type ReorderWorkflowsResponse struct {
+	Message string `json:"message"`
+}
+
 type AddAttributeTemplatesResponse struct {
 	Message string `json:"message"`
 }
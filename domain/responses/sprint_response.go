@@ -1,6 +1,10 @@
 package responses
 
-import "time"
+import (
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+)
 
 type CreateSprintResponse struct {
 	ID        string    `json:"id"`
@@ -13,3 +17,22 @@ type CreateSprintResponse struct {
 type EditSprintResponse struct {
 	Message string `json:"message"`
 }
+
+type StartSprintResponse struct {
+	Message string `json:"message"`
+}
+
+type CompleteSprintResponse struct {
+	Message              string `json:"message"`
+	CarriedOverTaskCount int64  `json:"carriedOverTaskCount"`
+}
+
+type ListSprintsResponse struct {
+	Sprints            []*models.Sprint   `json:"sprints"`
+	PaginationResponse PaginationResponse `json:"paginationResponse"`
+}
+
+type MoveTasksToSprintResponse struct {
+	Message          string `json:"message"`
+	UpdatedTaskCount int64  `json:"updatedTaskCount"`
+}
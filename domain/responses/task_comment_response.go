@@ -1 +1,5 @@
 package responses
+
+type DeleteTaskCommentResponse struct {
+	Message string `json:"message"`
+}
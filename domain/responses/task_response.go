@@ -6,6 +6,29 @@ import (
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 )
 
+type ListTasksResponse struct {
+	Tasks              []ListTasksResponseTask `json:"tasks"`
+	PaginationResponse PaginationResponse      `json:"paginationResponse"`
+}
+
+type ListTasksResponseTask struct {
+	ID         string                `json:"id"`
+	TaskID     string                `json:"taskId"`
+	ProjectID  string                `json:"projectId"`
+	Title      string                `json:"title"`
+	ParentID   *string               `json:"parentId"`
+	Type       models.TaskType       `json:"type"`
+	Status     string                `json:"status"`
+	Priority   *models.TaskPriority  `json:"priority"`
+	Assignee   []models.TaskAssignee `json:"assignee"`
+	Sprint     *models.TaskSprint    `json:"sprint"`
+	Attributes []models.KeyValuePair `json:"attributes"`
+	CreatedAt  time.Time             `json:"createdAt"`
+	CreatedBy  string                `json:"createdBy"`
+	UpdatedAt  time.Time             `json:"updatedAt"`
+	UpdatedBy  string                `json:"updatedBy"`
+}
+
 type GetTaskDetailResponse struct {
 	ID                 string                             `json:"id"`
 	TaskID             string                             `json:"taskId"`
@@ -19,6 +42,8 @@ type GetTaskDetailResponse struct {
 	Approval           []models.TaskApproval              `json:"approval"`
 	Assignee           []models.TaskAssignee              `json:"assignee"`
 	Sprint             *models.TaskSprint                 `json:"sprint"`
+	Attributes         []models.KeyValuePair              `json:"attributes"`
+	Mentions           []models.Mention                   `json:"mentions"`
 	CreatedAt          time.Time                          `json:"createdAt"`
 	CreatedBy          string                             `json:"createdBy"`
 	CreatorDisplayName string                             `json:"creatorDisplayName"`
@@ -29,11 +54,29 @@ type GetTaskDetailResponse struct {
 }
 
 type GetTaskDetailResponseTaskComment struct {
-	ID              string    `json:"id"`
-	Content         string    `json:"content"`
-	UserID          string    `json:"userId"`
-	UserDisplayName string    `json:"userDisplayName"`
-	TaskID          string    `json:"taskId"`
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt"`
+	ID              string                             `json:"id"`
+	Content         string                             `json:"content"`
+	UserID          string                             `json:"userId"`
+	UserDisplayName string                             `json:"userDisplayName"`
+	TaskID          string                             `json:"taskId"`
+	ParentID        *string                            `json:"parentId"`
+	Mentions        []models.Mention                   `json:"mentions"`
+	IsEdited        bool                               `json:"isEdited"`
+	IsDeleted       bool                               `json:"isDeleted"`
+	CreatedAt       time.Time                          `json:"createdAt"`
+	UpdatedAt       time.Time                          `json:"updatedAt"`
+	Replies         []GetTaskDetailResponseTaskComment `json:"replies,omitempty"`
+}
+
+type ListSimilarTasksResponse struct {
+	Tasks []SimilarTaskResponse `json:"tasks"`
+}
+
+type SimilarTaskResponse struct {
+	ID     string          `json:"id"`
+	TaskID string          `json:"taskId"`
+	Title  string          `json:"title"`
+	Type   models.TaskType `json:"type"`
+	Status string          `json:"status"`
+	Score  float64         `json:"score"`
 }
@@ -12,10 +12,20 @@ type UserResponse struct {
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
+type TokenResponse struct {
+	Token                string    `json:"token"`
+	TokenExpireAt        time.Time `json:"tokenExpireAt"`
+	RefreshToken         string    `json:"refreshToken"`
+	RefreshTokenExpireAt time.Time `json:"refreshTokenExpireAt"`
+}
+
 type UserWithTokenResponse struct {
 	UserResponse
-	Token         string    `json:"token"`
-	TokenExpireAt time.Time `json:"tokenExpireAt"`
+	TokenResponse
+}
+
+type LogoutResponse struct {
+	Message string `json:"message"`
 }
 
 type ListUserResponse struct {
@@ -0,0 +1,18 @@
+package responses
+
+import "github.com/cnc-csku/task-nexus/task-management/domain/models"
+
+type CreateWebhookResponse struct {
+	*models.Webhook
+	// Secret is only returned once, receivers use it to verify the payload signature
+	Secret string `json:"secret"`
+}
+
+type DeleteWebhookResponse struct {
+	Message string `json:"message"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries         []*models.WebhookDelivery `json:"deliveries"`
+	PaginationResponse PaginationResponse        `json:"paginationResponse"`
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type ActivityService interface {
+	ListTaskActivities(ctx context.Context, req *requests.ListTaskActivitiesRequest) (*responses.ListActivitiesResponse, *errutils.Error)
+	ListProjectActivities(ctx context.Context, req *requests.ListProjectActivitiesRequest) (*responses.ListActivitiesResponse, *errutils.Error)
+}
+
+type activityServiceImpl struct {
+	activityRepo repositories.ActivityRepository
+	taskRepo     repositories.TaskRepository
+}
+
+func NewActivityService(
+	activityRepo repositories.ActivityRepository,
+	taskRepo repositories.TaskRepository,
+) ActivityService {
+	return &activityServiceImpl{
+		activityRepo: activityRepo,
+		taskRepo:     taskRepo,
+	}
+}
+
+func normalizeListActivitiesPaginationRequest(req *requests.PaginationRequest) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 100
+	}
+	// Activities are a timeline, so they can only be sorted by time
+	req.SortBy = constant.ActivityFieldCreatedAt
+	if req.Order == "" {
+		req.Order = constant.DESC
+	}
+}
+
+func (s *activityServiceImpl) ListTaskActivities(ctx context.Context, req *requests.ListTaskActivitiesRequest) (*responses.ListActivitiesResponse, *errutils.Error) {
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	normalizeListActivitiesPaginationRequest(&req.PaginationRequest)
+
+	return s.search(ctx, &repositories.SearchActivityRequest{
+		ProjectID: task.ProjectID,
+		TaskID:    &task.TaskID,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+}
+
+func (s *activityServiceImpl) ListProjectActivities(ctx context.Context, req *requests.ListProjectActivitiesRequest) (*responses.ListActivitiesResponse, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	entityType := models.ActivityEntityType(req.EntityType)
+	if entityType != "" && !entityType.IsValid() {
+		return nil, errutils.NewError(exceptions.ErrInvalidActivityEntityType, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid entity type: %s", req.EntityType))
+	}
+
+	normalizeListActivitiesPaginationRequest(&req.PaginationRequest)
+
+	return s.search(ctx, &repositories.SearchActivityRequest{
+		ProjectID:  bsonProjectID,
+		EntityType: entityType,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+}
+
+func (s *activityServiceImpl) search(ctx context.Context, in *repositories.SearchActivityRequest) (*responses.ListActivitiesResponse, *errutils.Error) {
+	activities, totalActivity, err := s.activityRepo.Search(ctx, in)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.ListActivitiesResponse{
+		Activities: activities,
+		PaginationResponse: responses.PaginationResponse{
+			Page:      in.PaginationRequest.Page,
+			PageSize:  in.PaginationRequest.PageSize,
+			TotalPage: int(math.Ceil(float64(totalActivity) / float64(in.PaginationRequest.PageSize))),
+			TotalItem: int(totalActivity),
+		},
+	}, nil
+}
+
+// activityChanges collects field level differences, skipping fields whose value did not change
+type activityChanges []models.ActivityChange
+
+func (c *activityChanges) add(field string, oldValue interface{}, newValue interface{}) {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+
+	*c = append(*c, models.ActivityChange{
+		Field:    field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+func diffTask(before *models.Task, after *models.Task) []models.ActivityChange {
+	changes := activityChanges{}
+	changes.add("title", before.Title, after.Title)
+	changes.add("description", before.Description, after.Description)
+	changes.add("status", before.Status, after.Status)
+	changes.add("priority", before.Priority, after.Priority)
+	changes.add("assignee", before.Assignee, after.Assignee)
+	changes.add("approval", before.Approval, after.Approval)
+	changes.add("sprint", taskCurrentSprintID(before), taskCurrentSprintID(after))
+	changes.add("attributes", before.Attributes, after.Attributes)
+
+	return changes
+}
+
+func taskCurrentSprintID(task *models.Task) *bson.ObjectID {
+	if task.Sprint == nil {
+		return nil
+	}
+	return task.Sprint.CurrentSprintID
+}
+
+// recordTaskActivity stores the differences between two versions of a task, nothing is stored if they are equal
+func recordTaskActivity(ctx context.Context, activityRepo repositories.ActivityRepository, before *models.Task, after *models.Task, userID bson.ObjectID) *errutils.Error {
+	changes := diffTask(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	err := activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  after.ProjectID,
+		TaskID:     &after.TaskID,
+		EntityType: models.ActivityEntityTypeTask,
+		EntityID:   after.ID.Hex(),
+		Action:     models.ActivityActionUpdated,
+		Changes:    changes,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		return errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,578 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/array"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// maxExistingChildTasks limits how many existing child tasks are listed in the breakdown prompt
+const maxExistingChildTasks = 50
+
+type AIService interface {
+	BreakdownTask(ctx context.Context, req *requests.BreakdownTaskRequest, userID string) (*responses.BreakdownTaskResponse, *errutils.Error)
+	BreakdownTaskStream(ctx context.Context, req *requests.BreakdownTaskRequest, userID string, onChunk repositories.LLMChunkHandler) (*responses.BreakdownTaskResponse, *errutils.Error)
+	SummarizeSprint(ctx context.Context, req *requests.SummarizeSprintRequest, userID string) (*responses.SummarizeSprintResponse, *errutils.Error)
+}
+
+type aiServiceImpl struct {
+	taskRepo          repositories.TaskRepository
+	taskCommentRepo   repositories.TaskCommentRepository
+	projectRepo       repositories.ProjectRepository
+	sprintRepo        repositories.SprintRepository
+	userRepo          repositories.UserRepository
+	llmRepo           repositories.LLMRepository
+	taskService       TaskService
+	boardEventService BoardEventService
+	config            *config.Config
+}
+
+func NewAIService(
+	taskRepo repositories.TaskRepository,
+	taskCommentRepo repositories.TaskCommentRepository,
+	projectRepo repositories.ProjectRepository,
+	sprintRepo repositories.SprintRepository,
+	userRepo repositories.UserRepository,
+	llmRepo repositories.LLMRepository,
+	taskService TaskService,
+	boardEventService BoardEventService,
+	config *config.Config,
+) AIService {
+	return &aiServiceImpl{
+		taskRepo:          taskRepo,
+		taskCommentRepo:   taskCommentRepo,
+		projectRepo:       projectRepo,
+		sprintRepo:        sprintRepo,
+		userRepo:          userRepo,
+		llmRepo:           llmRepo,
+		taskService:       taskService,
+		boardEventService: boardEventService,
+		config:            config,
+	}
+}
+
+// taskBreakdownResult is the JSON shape the model is asked to answer with
+type taskBreakdownResult struct {
+	Tasks []struct {
+		Title       string  `json:"title"`
+		Description *string `json:"description"`
+		Type        string  `json:"type"`
+	} `json:"tasks"`
+}
+
+// childTaskTypes returns the task types that can be created under a parent, following validateParentTaskType
+func childTaskTypes(parentTaskType models.TaskType) []models.TaskType {
+	switch parentTaskType {
+	case models.TaskTypeEpic:
+		return []models.TaskType{models.TaskTypeStory, models.TaskTypeTask, models.TaskTypeBug}
+	case models.TaskTypeStory, models.TaskTypeTask, models.TaskTypeBug:
+		return []models.TaskType{models.TaskTypeSubTask}
+	}
+	return nil
+}
+
+// BreakdownTask proposes child tasks for an epic, story, task or bug.
+// Reviewed proposals can be sent back in req.Tasks to create them without calling the model again.
+func (s *aiServiceImpl) BreakdownTask(ctx context.Context, req *requests.BreakdownTaskRequest, userID string) (*responses.BreakdownTaskResponse, *errutils.Error) {
+	return s.breakdownTask(ctx, req, userID, nil)
+}
+
+// BreakdownTaskStream works like BreakdownTask but passes the model output to onChunk while it is generated
+func (s *aiServiceImpl) BreakdownTaskStream(ctx context.Context, req *requests.BreakdownTaskRequest, userID string, onChunk repositories.LLMChunkHandler) (*responses.BreakdownTaskResponse, *errutils.Error) {
+	return s.breakdownTask(ctx, req, userID, onChunk)
+}
+
+func (s *aiServiceImpl) breakdownTask(ctx context.Context, req *requests.BreakdownTaskRequest, userID string, onChunk repositories.LLMChunkHandler) (*responses.BreakdownTaskResponse, *errutils.Error) {
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.NotFound).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	allowedTypes := childTaskTypes(task.Type)
+	if len(allowedTypes) == 0 {
+		return nil, errutils.NewError(exceptions.ErrTaskCannotBeBrokenDown, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task type can not have child tasks: %s", task.Type))
+	}
+
+	var proposals []responses.BreakdownTaskResponseProposal
+	if len(req.Tasks) > 0 {
+		for _, reviewedTask := range req.Tasks {
+			if serviceErr := validateParentTaskType(reviewedTask.Type, task.Type); serviceErr != nil {
+				return nil, serviceErr
+			}
+			if !containsTaskType(allowedTypes, models.TaskType(reviewedTask.Type)) {
+				return nil, errutils.NewError(exceptions.ErrInvalidTaskType, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid child task type: %s", reviewedTask.Type))
+			}
+
+			proposals = append(proposals, responses.BreakdownTaskResponseProposal{
+				Title:       reviewedTask.Title,
+				Description: reviewedTask.Description,
+				Type:        models.TaskType(reviewedTask.Type),
+			})
+		}
+	} else {
+		var serviceErr *errutils.Error
+		proposals, serviceErr = s.generateBreakdown(ctx, task, allowedTypes, req.Instructions, onChunk)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
+	res := &responses.BreakdownTaskResponse{
+		ParentTaskID: task.TaskID,
+		Proposals:    proposals,
+		CreatedTasks: []*models.Task{},
+	}
+
+	if !req.Create {
+		return res, nil
+	}
+
+	// The child tasks are created together, so a failure does not leave half of the breakdown behind
+	projectID := task.ProjectID.Hex()
+	createTaskReqs := make([]*requests.CreateTaskRequest, 0, len(proposals))
+	for _, proposal := range proposals {
+		createTaskReqs = append(createTaskReqs, &requests.CreateTaskRequest{
+			ProjectID:   projectID,
+			Title:       proposal.Title,
+			Description: proposal.Description,
+			ParentID:    &task.TaskID,
+			Type:        proposal.Type.String(),
+		})
+	}
+
+	createdTasks, serviceErr := s.taskService.CreateMany(ctx, createTaskReqs, userID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	res.CreatedTasks = createdTasks
+
+	return res, nil
+}
+
+func (s *aiServiceImpl) generateBreakdown(ctx context.Context, task *models.Task, allowedTypes []models.TaskType, instructions *string, onChunk repositories.LLMChunkHandler) ([]responses.BreakdownTaskResponseProposal, *errutils.Error) {
+	project, err := s.projectRepo.FindByProjectID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if project == nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.NotFound).WithDebugMessage("project not found")
+	}
+
+	existingChildTasks, _, err := s.taskRepo.Search(ctx, &repositories.SearchTaskRequest{
+		ProjectID: task.ProjectID,
+		ParentID:  task.TaskID,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     1,
+			PageSize: maxExistingChildTasks,
+			SortBy:   constant.TaskFieldCreatedAt,
+			Order:    constant.ASC,
+		},
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	prompt := buildTaskBreakdownPrompt(project, task, existingChildTasks, allowedTypes, instructions)
+
+	output, err := s.complete(ctx, &repositories.LLMCompletionRequest{
+		Model:  s.config.LLM.Model.TaskBreakdown,
+		Prompt: prompt,
+		JSON:   true,
+	}, onChunk)
+	if err != nil {
+		return nil, newAIError(err)
+	}
+
+	result := new(taskBreakdownResult)
+	if err := json.Unmarshal([]byte(extractJSONObject(output)), result); err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidAIResponse, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	// The model does not always follow the type rules, so invalid proposals are fixed up or dropped
+	proposals := make([]responses.BreakdownTaskResponseProposal, 0, len(result.Tasks))
+	for _, proposedTask := range result.Tasks {
+		title := strings.TrimSpace(proposedTask.Title)
+		if title == "" {
+			continue
+		}
+
+		taskType := models.TaskType(strings.ToUpper(strings.TrimSpace(proposedTask.Type)))
+		if !containsTaskType(allowedTypes, taskType) {
+			if len(allowedTypes) != 1 {
+				continue
+			}
+			taskType = allowedTypes[0]
+		}
+
+		proposals = append(proposals, responses.BreakdownTaskResponseProposal{
+			Title:       title,
+			Description: proposedTask.Description,
+			Type:        taskType,
+		})
+	}
+
+	if len(proposals) == 0 {
+		return nil, errutils.NewError(exceptions.ErrEmptyTaskBreakdown, errutils.UnprocessableEntity).WithDebugMessage(output)
+	}
+
+	return proposals, nil
+}
+
+// sprintSummaryResult is the JSON shape the model is asked to answer with.
+// Completed and carried over tasks come from the task statuses, so the model can not get them wrong.
+type sprintSummaryResult struct {
+	Summary    string   `json:"summary"`
+	Highlights []string `json:"highlights"`
+	Blockers   []string `json:"blockers"`
+}
+
+// SummarizeSprint drafts a retrospective note from the sprint's tasks, assignees and comments.
+// The note is stored on the sprint when req.Save is set, replacing the previous one.
+func (s *aiServiceImpl) SummarizeSprint(ctx context.Context, req *requests.SummarizeSprintRequest, userID string) (*responses.SummarizeSprintResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonSprintID, err := bson.ObjectIDFromHex(req.SprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	sprint, err := s.sprintRepo.FindByID(ctx, bsonSprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
+	}
+
+	if sprint.Status == models.SprintStatusPlanned {
+		return nil, errutils.NewError(exceptions.ErrSprintNotStarted, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Sprint status: %s", sprint.Status))
+	}
+
+	project, err := s.projectRepo.FindByProjectID(ctx, bsonProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if project == nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.NotFound)
+	}
+
+	tasks, err := s.taskRepo.FindBySprintHistory(ctx, bsonProjectID, bsonSprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	if len(tasks) == 0 {
+		return nil, errutils.NewError(exceptions.ErrSprintHasNoTasks, errutils.BadRequest)
+	}
+
+	doneStatuses := models.GetDoneStatuses(project.Workflows)
+
+	// Tasks that left the sprint, by carry over or by hand, were not finished in it
+	completedTasks := make([]*models.Task, 0)
+	carriedOverTasks := make([]*models.Task, 0)
+	for _, task := range tasks {
+		if task.Sprint != nil && task.Sprint.CurrentSprintID != nil && *task.Sprint.CurrentSprintID == bsonSprintID && array.ContainAny(doneStatuses, []string{task.Status}) {
+			completedTasks = append(completedTasks, task)
+		} else {
+			carriedOverTasks = append(carriedOverTasks, task)
+		}
+	}
+
+	taskIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+
+	comments, err := s.taskCommentRepo.FindByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	commentsByTaskID := make(map[string][]*models.TaskComment)
+	for _, comment := range comments {
+		commentsByTaskID[comment.TaskID] = append(commentsByTaskID[comment.TaskID], comment)
+	}
+
+	userNames, err := s.findUserNames(ctx, tasks, comments)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	prompt := buildSprintSummaryPrompt(project, sprint, completedTasks, carriedOverTasks, commentsByTaskID, userNames, req.Instructions)
+
+	output, err := s.llmRepo.Complete(ctx, &repositories.LLMCompletionRequest{
+		Model:  s.config.LLM.Model.SprintSummary,
+		Prompt: prompt,
+		JSON:   true,
+	})
+	if err != nil {
+		return nil, newAIError(err)
+	}
+
+	result := new(sprintSummaryResult)
+	if err := json.Unmarshal([]byte(extractJSONObject(output)), result); err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidAIResponse, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	retrospective := &models.SprintRetrospective{
+		Summary:     strings.TrimSpace(result.Summary),
+		Completed:   toSprintRetrospectiveTasks(completedTasks),
+		CarriedOver: toSprintRetrospectiveTasks(carriedOverTasks),
+		Blockers:    nonEmptyStrings(result.Blockers),
+		Highlights:  nonEmptyStrings(result.Highlights),
+		CreatedAt:   time.Now(),
+		CreatedBy:   bsonUserID,
+	}
+
+	if req.Save {
+		err := s.sprintRepo.UpdateRetrospective(ctx, &repositories.UpdateSprintRetrospectiveRequest{
+			ID:            bsonSprintID,
+			Retrospective: retrospective,
+			UpdatedBy:     bsonUserID,
+		})
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+
+		sprint.Retrospective = retrospective
+		s.boardEventService.Publish(ctx, models.BoardEventTypeSprintUpdated, sprint.ProjectID, sprint, bsonUserID)
+	}
+
+	return &responses.SummarizeSprintResponse{
+		SprintID:      sprint.ID.Hex(),
+		Retrospective: retrospective,
+		Saved:         req.Save,
+	}, nil
+}
+
+// findUserNames maps the assignees and comment authors to their display names
+func (s *aiServiceImpl) findUserNames(ctx context.Context, tasks []*models.Task, comments []*models.TaskComment) (map[bson.ObjectID]string, error) {
+	userIDs := make([]bson.ObjectID, 0)
+	for _, task := range tasks {
+		for _, assignee := range task.Assignee {
+			userIDs = append(userIDs, assignee.Value)
+		}
+	}
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.UserID)
+	}
+
+	userNames := make(map[bson.ObjectID]string)
+	if len(userIDs) == 0 {
+		return userNames, nil
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		userNames[user.ID] = user.DisplayName
+	}
+
+	return userNames, nil
+}
+
+func buildSprintSummaryPrompt(
+	project *models.Project,
+	sprint *models.Sprint,
+	completedTasks []*models.Task,
+	carriedOverTasks []*models.Task,
+	commentsByTaskID map[string][]*models.TaskComment,
+	userNames map[bson.ObjectID]string,
+	instructions *string,
+) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are helping a software team write the retrospective of their sprint.\n\n")
+
+	fmt.Fprintf(&prompt, "Project: %s\n", project.Name)
+	fmt.Fprintf(&prompt, "Sprint: %s (%s)\n", sprint.Title, sprint.Status)
+	if sprint.SprintGoal != nil && *sprint.SprintGoal != "" {
+		fmt.Fprintf(&prompt, "Sprint goal: %s\n", *sprint.SprintGoal)
+	}
+	if sprint.StartDate != nil && sprint.EndDate != nil {
+		fmt.Fprintf(&prompt, "Dates: %s to %s\n", sprint.StartDate.Format(constant.DateFormat), sprint.EndDate.Format(constant.DateFormat))
+	}
+
+	writeTasks := func(heading string, tasks []*models.Task) {
+		if len(tasks) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&prompt, "\n%s:\n", heading)
+		for _, task := range tasks {
+			fmt.Fprintf(&prompt, "- %s [%s, %s] %s", task.TaskID, task.Type, task.Status, task.Title)
+
+			assigneeNames := make([]string, 0, len(task.Assignee))
+			for _, assignee := range task.Assignee {
+				if name, ok := userNames[assignee.Value]; ok {
+					assigneeNames = append(assigneeNames, name)
+				}
+			}
+			if len(assigneeNames) > 0 {
+				fmt.Fprintf(&prompt, " (assignees: %s)", strings.Join(assigneeNames, ", "))
+			}
+			prompt.WriteString("\n")
+
+			comments := commentsByTaskID[task.TaskID]
+			if len(comments) > constant.SprintSummaryMaxCommentsPerTask {
+				comments = comments[len(comments)-constant.SprintSummaryMaxCommentsPerTask:]
+			}
+			for _, comment := range comments {
+				content := strings.Join(strings.Fields(comment.Content), " ")
+				if runes := []rune(content); len(runes) > constant.SprintSummaryMaxCommentLength {
+					content = string(runes[:constant.SprintSummaryMaxCommentLength]) + "..."
+				}
+				fmt.Fprintf(&prompt, "  - comment by %s: %s\n", userNames[comment.UserID], content)
+			}
+		}
+	}
+
+	writeTasks("Completed tasks", completedTasks)
+	writeTasks("Tasks not completed in this sprint", carriedOverTasks)
+
+	if instructions != nil && *instructions != "" {
+		fmt.Fprintf(&prompt, "\nAdditional instructions: %s\n", *instructions)
+	}
+
+	prompt.WriteString("\nRules:\n")
+	prompt.WriteString("- summary is one short paragraph about what the sprint achieved compared to its goal\n")
+	prompt.WriteString("- highlights are notable results or things that went well\n")
+	prompt.WriteString("- blockers are problems that slowed the team down, mention the task IDs they relate to\n")
+	prompt.WriteString("- only use facts from the tasks and comments above, use an empty list when there is nothing to say\n")
+	prompt.WriteString("\nAnswer with JSON only, without any other text, in this format:\n")
+	prompt.WriteString(`{"summary": "...", "highlights": ["..."], "blockers": ["..."]}`)
+
+	return prompt.String()
+}
+
+func toSprintRetrospectiveTasks(tasks []*models.Task) []models.SprintRetrospectiveTask {
+	retrospectiveTasks := make([]models.SprintRetrospectiveTask, 0, len(tasks))
+	for _, task := range tasks {
+		retrospectiveTasks = append(retrospectiveTasks, models.SprintRetrospectiveTask{
+			TaskID: task.TaskID,
+			Title:  task.Title,
+			Status: task.Status,
+		})
+	}
+	return retrospectiveTasks
+}
+
+func nonEmptyStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// complete returns the whole model output, streaming it through onChunk when one is given
+func (s *aiServiceImpl) complete(ctx context.Context, in *repositories.LLMCompletionRequest, onChunk repositories.LLMChunkHandler) (string, error) {
+	if onChunk == nil {
+		return s.llmRepo.Complete(ctx, in)
+	}
+
+	var output strings.Builder
+	err := s.llmRepo.CompleteStream(ctx, in, func(chunk string) error {
+		output.WriteString(chunk)
+		return onChunk(chunk)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
+
+func newAIError(err error) *errutils.Error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errutils.NewError(exceptions.ErrAITimeout, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+	return errutils.NewError(exceptions.ErrAIUnavailable, errutils.InternalServerError).WithDebugMessage(err.Error())
+}
+
+func buildTaskBreakdownPrompt(project *models.Project, task *models.Task, existingChildTasks []*models.Task, allowedTypes []models.TaskType, instructions *string) string {
+	var prompt strings.Builder
+
+	prompt.WriteString("You are helping a software team plan their work. Break the task below into smaller child tasks.\n\n")
+
+	fmt.Fprintf(&prompt, "Project: %s\n", project.Name)
+	if project.Description != nil && *project.Description != "" {
+		fmt.Fprintf(&prompt, "Project description: %s\n", *project.Description)
+	}
+
+	fmt.Fprintf(&prompt, "\nTask type: %s\n", task.Type)
+	fmt.Fprintf(&prompt, "Task title: %s\n", task.Title)
+	if task.Description != nil && *task.Description != "" {
+		fmt.Fprintf(&prompt, "Task description: %s\n", *task.Description)
+	}
+
+	if len(existingChildTasks) > 0 {
+		prompt.WriteString("\nChild tasks that already exist, do not repeat them:\n")
+		for _, childTask := range existingChildTasks {
+			fmt.Fprintf(&prompt, "- [%s] %s\n", childTask.Type, childTask.Title)
+		}
+	}
+
+	if instructions != nil && *instructions != "" {
+		fmt.Fprintf(&prompt, "\nAdditional instructions: %s\n", *instructions)
+	}
+
+	typeNames := make([]string, 0, len(allowedTypes))
+	for _, allowedType := range allowedTypes {
+		typeNames = append(typeNames, allowedType.String())
+	}
+
+	prompt.WriteString("\nRules:\n")
+	fmt.Fprintf(&prompt, "- The type of every child task must be one of: %s\n", strings.Join(typeNames, ", "))
+	prompt.WriteString("- Titles are short and start with a verb, descriptions are one or two sentences\n")
+	prompt.WriteString("- Propose between 3 and 8 child tasks\n")
+	prompt.WriteString("\nAnswer with JSON only, without any other text, in this format:\n")
+	prompt.WriteString(`{"tasks": [{"title": "...", "description": "...", "type": "` + typeNames[0] + `"}]}`)
+
+	return prompt.String()
+}
+
+// extractJSONObject strips anything around the outermost JSON object, models often wrap it in markdown fences
+func extractJSONObject(output string) string {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start == -1 || end < start {
+		return output
+	}
+	return output[start : end+1]
+}
+
+func containsTaskType(taskTypes []models.TaskType, taskType models.TaskType) bool {
+	for _, t := range taskTypes {
+		if t == taskType {
+			return true
+		}
+	}
+	return false
+}
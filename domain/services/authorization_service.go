@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type AuthorizationService interface {
+	AuthorizeWorkspace(ctx context.Context, workspaceID string, userID string, permission models.Permission) (*models.WorkspaceMember, *errutils.Error)
+	AuthorizeProject(ctx context.Context, projectID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error)
+	AuthorizeTask(ctx context.Context, taskID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error)
+}
+
+type authorizationServiceImpl struct {
+	workspaceMemberRepo repositories.WorkspaceMemberRepository
+	projectRepo         repositories.ProjectRepository
+	projectMemberRepo   repositories.ProjectMemberRepository
+	taskRepo            repositories.TaskRepository
+}
+
+func NewAuthorizationService(
+	workspaceMemberRepo repositories.WorkspaceMemberRepository,
+	projectRepo repositories.ProjectRepository,
+	projectMemberRepo repositories.ProjectMemberRepository,
+	taskRepo repositories.TaskRepository,
+) AuthorizationService {
+	return &authorizationServiceImpl{
+		workspaceMemberRepo: workspaceMemberRepo,
+		projectRepo:         projectRepo,
+		projectMemberRepo:   projectMemberRepo,
+		taskRepo:            taskRepo,
+	}
+}
+
+func (s *authorizationServiceImpl) AuthorizeWorkspace(ctx context.Context, workspaceID string, userID string, permission models.Permission) (*models.WorkspaceMember, *errutils.Error) {
+	if permission.Scope() != models.PermissionScopeWorkspace {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(fmt.Sprintf("Permission is not workspace scoped: %s", permission))
+	}
+
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonWorkspaceID, err := bson.ObjectIDFromHex(workspaceID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidWorkspaceID, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	member, serviceErr := s.findWorkspaceMember(ctx, bsonWorkspaceID, bsonUserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if !member.Role.Can(permission) {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden).WithDebugMessage(fmt.Sprintf("Workspace role %s cannot %s", member.Role, permission))
+	}
+
+	return member, nil
+}
+
+func (s *authorizationServiceImpl) AuthorizeProject(ctx context.Context, projectID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(projectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	return s.authorizeProject(ctx, bsonProjectID, userID, permission)
+}
+
+func (s *authorizationServiceImpl) AuthorizeTask(ctx context.Context, taskID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error) {
+	task, err := s.taskRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.NotFound).WithDebugMessage(fmt.Sprintf("Task not found: %s", taskID))
+	}
+
+	return s.authorizeProject(ctx, task.ProjectID, userID, permission)
+}
+
+func (s *authorizationServiceImpl) authorizeProject(ctx context.Context, projectID bson.ObjectID, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error) {
+	if permission.Scope() != models.PermissionScopeProject {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(fmt.Sprintf("Permission is not project scoped: %s", permission))
+	}
+
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	project, err := s.projectRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if project == nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.NotFound)
+	}
+
+	// The caller must still belong to the workspace that owns the project
+	if _, serviceErr := s.findWorkspaceMember(ctx, project.WorkspaceID, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, projectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden).WithDebugMessage("User is not a member of the project")
+	}
+
+	if !member.Role.Can(permission) {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden).WithDebugMessage(fmt.Sprintf("Project role %s cannot %s", member.Role, permission))
+	}
+
+	return member, nil
+}
+
+func (s *authorizationServiceImpl) findWorkspaceMember(ctx context.Context, workspaceID bson.ObjectID, userID bson.ObjectID) (*models.WorkspaceMember, *errutils.Error) {
+	member, err := s.workspaceMemberRepo.FindByWorkspaceIDAndUserID(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrMemberNotFoundInWorkspace, errutils.Forbidden)
+	}
+
+	return member, nil
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type BoardEventService interface {
+	Publish(ctx context.Context, eventType models.BoardEventType, projectID bson.ObjectID, payload interface{}, actorID bson.ObjectID)
+	Subscribe(ctx context.Context, req *requests.StreamBoardEventsRequest) (<-chan *models.BoardEvent, func(), *errutils.Error)
+}
+
+type boardEventServiceImpl struct {
+	boardEventHub repositories.BoardEventHub
+}
+
+func NewBoardEventService(boardEventHub repositories.BoardEventHub) BoardEventService {
+	return &boardEventServiceImpl{
+		boardEventHub: boardEventHub,
+	}
+}
+
+// Publish sends an event to everyone watching the project board.
+// Board events are best effort, so a failure is logged instead of failing the mutation that caused it.
+func (s *boardEventServiceImpl) Publish(ctx context.Context, eventType models.BoardEventType, projectID bson.ObjectID, payload interface{}, actorID bson.ObjectID) {
+	err := s.boardEventHub.Publish(ctx, &models.BoardEvent{
+		Type:      eventType,
+		ProjectID: projectID,
+		ActorID:   actorID,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to publish %s event for project %s: %v\n", eventType, projectID.Hex(), err)
+	}
+}
+
+func (s *boardEventServiceImpl) Subscribe(ctx context.Context, req *requests.StreamBoardEventsRequest) (<-chan *models.BoardEvent, func(), *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	events, unsubscribe, err := s.boardEventHub.Subscribe(ctx, bsonProjectID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return events, unsubscribe, nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+)
+
+type HealthService interface {
+	Liveness(ctx context.Context) *responses.HealthLivenessResponse
+	Readiness(ctx context.Context) *responses.HealthReadinessResponse
+}
+
+type healthServiceImpl struct {
+	config         *config.Config
+	healthCheckers []repositories.HealthChecker
+}
+
+func NewHealthService(config *config.Config, healthCheckers []repositories.HealthChecker) HealthService {
+	return &healthServiceImpl{
+		config:         config,
+		healthCheckers: healthCheckers,
+	}
+}
+
+// Liveness only reports that the process can serve requests, it does not touch dependencies
+func (s *healthServiceImpl) Liveness(ctx context.Context) *responses.HealthLivenessResponse {
+	return &responses.HealthLivenessResponse{
+		Status: models.HealthStatusUp,
+	}
+}
+
+// Readiness runs every dependency check concurrently, each bounded by the configured timeout.
+// The app is ready only when every check passes.
+func (s *healthServiceImpl) Readiness(ctx context.Context) *responses.HealthReadinessResponse {
+	checks := make([]responses.HealthReadinessResponseCheck, len(s.healthCheckers))
+
+	var wg sync.WaitGroup
+	for i, checker := range s.healthCheckers {
+		wg.Add(1)
+		go func(i int, checker repositories.HealthChecker) {
+			defer wg.Done()
+			checks[i] = s.check(ctx, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	status := models.HealthStatusUp
+	for _, check := range checks {
+		if check.Status != models.HealthStatusUp {
+			status = models.HealthStatusDown
+			break
+		}
+	}
+
+	return &responses.HealthReadinessResponse{
+		Status: status,
+		Checks: checks,
+	}
+}
+
+func (s *healthServiceImpl) check(ctx context.Context, checker repositories.HealthChecker) responses.HealthReadinessResponseCheck {
+	ctx, cancel := context.WithTimeout(ctx, s.config.HealthCheck.Timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	err := checker.Check(ctx)
+	latency := time.Since(startedAt)
+
+	check := responses.HealthReadinessResponseCheck{
+		Name:      checker.Name(),
+		Status:    models.HealthStatusUp,
+		LatencyMs: latency.Milliseconds(),
+	}
+	if err != nil {
+		errMessage := err.Error()
+		check.Status = models.HealthStatusDown
+		check.Error = &errMessage
+	}
+
+	return check
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -28,6 +29,7 @@ type invitationServiceImpl struct {
 	workspaceRepo       repositories.WorkspaceRepository
 	invitationRepo      repositories.InvitationRepository
 	workspaceMemberRepo repositories.WorkspaceMemberRepository
+	notificationService NotificationService
 	config              *config.Config
 }
 
@@ -36,6 +38,7 @@ func NewInvitationService(
 	workspaceRepo repositories.WorkspaceRepository,
 	invitationRepo repositories.InvitationRepository,
 	workspaceMemberRepo repositories.WorkspaceMemberRepository,
+	notificationService NotificationService,
 	config *config.Config,
 ) InvitationService {
 	return &invitationServiceImpl{
@@ -43,6 +46,7 @@ func NewInvitationService(
 		workspaceRepo:       workspaceRepo,
 		invitationRepo:      invitationRepo,
 		workspaceMemberRepo: workspaceMemberRepo,
+		notificationService: notificationService,
 		config:              config,
 	}
 }
@@ -69,7 +73,7 @@ func (i *invitationServiceImpl) Create(ctx context.Context, req *requests.Create
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
 	} else if inviter == nil {
 		return nil, errutils.NewError(exceptions.ErrMemberNotFoundInWorkspace, errutils.BadRequest).WithDebugMessage("Inviter not found in workspace")
-	} else if inviter.Role != models.WorkspaceMemberRoleOwner {
+	} else if !inviter.Role.Can(models.PermissionWorkspaceInvite) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("Inviter is not an owner")
 	}
 
@@ -89,6 +93,13 @@ func (i *invitationServiceImpl) Create(ctx context.Context, req *requests.Create
 		return nil, errutils.NewError(exceptions.ErrInvitationAlreadySent, errutils.BadRequest).WithDebugMessage("Invitee is already invited to the workspace")
 	}
 
+	workspace, err := i.workspaceRepo.FindByID(ctx, bsonWorkspaceID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if workspace == nil {
+		return nil, errutils.NewError(exceptions.ErrWorkspaceNotFound, errutils.BadRequest).WithDebugMessage("Workspace not found")
+	}
+
 	// Create the invitation
 	createInvitationReq := &repositories.CreateInvitationRequest{
 		WorkspaceID:   bsonWorkspaceID,
@@ -105,6 +116,16 @@ func (i *invitationServiceImpl) Create(ctx context.Context, req *requests.Create
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
 	}
 
+	i.notificationService.Notify(ctx, []*repositories.CreateNotificationRequest{
+		{
+			UserID:      bsonInviteeUserID,
+			Type:        models.NotificationTypeInvitationReceived,
+			Message:     fmt.Sprintf("You were invited to join %s", workspace.Name),
+			WorkspaceID: &bsonWorkspaceID,
+			ActorID:     bsonInviterUserID,
+		},
+	})
+
 	return &responses.CreateInvitationResponse{
 		Message: "Invitation sent successfully",
 	}, nil
@@ -212,9 +233,9 @@ func (i *invitationServiceImpl) ListForWorkspaceOwner(ctx context.Context, req *
 	member, err := i.workspaceMemberRepo.FindByWorkspaceIDAndUserID(ctx, bsonWorkspaceID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrMemberNotFoundInWorkspace, errutils.BadRequest).WithDebugMessage("User not found in workspace")
-	} else if member.Role != models.WorkspaceMemberRoleOwner {
+	} else if !member.Role.Can(models.PermissionWorkspaceInvite) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not an owner")
 	}
 
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var mentionPattern = regexp.MustCompile(`@([\p{L}\p{N}_.\-]+)`)
+
+// extractMentionTokens returns the distinct words following an "@" in text
+func extractMentionTokens(text string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(text, -1)
+
+	seen := make(map[string]bool, len(matches))
+	tokens := make([]string, 0, len(matches))
+	for _, match := range matches {
+		// Trailing punctuation such as "@john." belongs to the sentence, not the name
+		token := strings.TrimRight(match[1], ".-")
+		if token == "" || seen[token] {
+			continue
+		}
+		seen[token] = true
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// resolveMentions matches @userId and @displayName tokens in text against the active members of a project.
+// Tokens that do not match a member are left as plain text.
+func resolveMentions(
+	ctx context.Context,
+	projectMemberRepo repositories.ProjectMemberRepository,
+	userRepo repositories.UserRepository,
+	projectID bson.ObjectID,
+	text *string,
+) ([]models.Mention, error) {
+	mentions := []models.Mention{}
+	if text == nil {
+		return mentions, nil
+	}
+
+	tokens := extractMentionTokens(*text)
+	if len(tokens) == 0 {
+		return mentions, nil
+	}
+
+	members, err := projectMemberRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberUserIDs := make([]bson.ObjectID, 0, len(members))
+	for _, member := range members {
+		if member.RemovedAt == nil {
+			memberUserIDs = append(memberUserIDs, member.UserID)
+		}
+	}
+	if len(memberUserIDs) == 0 {
+		return mentions, nil
+	}
+
+	users, err := userRepo.FindByIDs(ctx, memberUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByID := make(map[string]models.User, len(users))
+	usersByDisplayName := make(map[string]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID.Hex()] = user
+		usersByDisplayName[strings.ToLower(user.DisplayName)] = user
+	}
+
+	mentioned := make(map[bson.ObjectID]bool, len(tokens))
+	for _, token := range tokens {
+		user, ok := usersByID[token]
+		if !ok {
+			user, ok = usersByDisplayName[strings.ToLower(token)]
+		}
+		if !ok || mentioned[user.ID] {
+			continue
+		}
+
+		mentioned[user.ID] = true
+		mentions = append(mentions, models.Mention{
+			UserID:      user.ID,
+			DisplayName: user.DisplayName,
+		})
+	}
+
+	return mentions, nil
+}
+
+// notifyMentionedUsers notifies users that appear in mentions but not in previousMentions
+func notifyMentionedUsers(
+	ctx context.Context,
+	notificationService NotificationService,
+	mentions []models.Mention,
+	previousMentions []models.Mention,
+	projectID bson.ObjectID,
+	taskID string,
+	actorID bson.ObjectID,
+	message string,
+) {
+	alreadyMentioned := make(map[bson.ObjectID]bool, len(previousMentions))
+	for _, mention := range previousMentions {
+		alreadyMentioned[mention.UserID] = true
+	}
+
+	notifications := make([]*repositories.CreateNotificationRequest, 0, len(mentions))
+	for _, mention := range mentions {
+		if alreadyMentioned[mention.UserID] {
+			continue
+		}
+
+		notifications = append(notifications, &repositories.CreateNotificationRequest{
+			UserID:    mention.UserID,
+			Type:      models.NotificationTypeMentioned,
+			Message:   message,
+			ProjectID: &projectID,
+			TaskID:    &taskID,
+			ActorID:   actorID,
+		})
+	}
+
+	notificationService.Notify(ctx, notifications)
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type NotificationService interface {
+	Notify(ctx context.Context, in []*repositories.CreateNotificationRequest)
+	List(ctx context.Context, req *requests.ListNotificationsRequest, userID string) (*responses.ListNotificationsResponse, *errutils.Error)
+	MarkAsRead(ctx context.Context, req *requests.MarkNotificationAsReadRequest, userID string) (*models.Notification, *errutils.Error)
+	MarkAllAsRead(ctx context.Context, userID string) (*responses.MarkAllNotificationsAsReadResponse, *errutils.Error)
+	Subscribe(ctx context.Context, userID string) (<-chan *models.Notification, func(), *errutils.Error)
+}
+
+type notificationServiceImpl struct {
+	notificationRepo      repositories.NotificationRepository
+	notificationPublisher repositories.NotificationPublisher
+}
+
+func NewNotificationService(
+	notificationRepo repositories.NotificationRepository,
+	notificationPublisher repositories.NotificationPublisher,
+) NotificationService {
+	return &notificationServiceImpl{
+		notificationRepo:      notificationRepo,
+		notificationPublisher: notificationPublisher,
+	}
+}
+
+// Notify stores the notifications and then pushes them to connected users.
+// Recipients that are also the actor are skipped.
+// Notifications are best effort, so a failure is logged instead of failing the mutation that caused it.
+func (s *notificationServiceImpl) Notify(ctx context.Context, in []*repositories.CreateNotificationRequest) {
+	filtered := make([]*repositories.CreateNotificationRequest, 0, len(in))
+	for _, notification := range in {
+		if notification.UserID != notification.ActorID {
+			filtered = append(filtered, notification)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return
+	}
+
+	notifications, err := s.notificationRepo.CreateMany(ctx, filtered)
+	if err != nil {
+		log.Printf("⚠️ Failed to store %d notifications: %v\n", len(filtered), err)
+		return
+	}
+
+	// Stored notifications are still listed if the push fails, so a failed push does not fail the request
+	for _, notification := range notifications {
+		if err := s.notificationPublisher.Publish(ctx, notification); err != nil {
+			log.Printf("⚠️ Failed to publish notification %s: %v\n", notification.ID.Hex(), err)
+		}
+	}
+}
+
+func normalizeListNotificationsPaginationRequest(req *requests.PaginationRequest) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 100
+	}
+	// Notifications are a timeline, so they can only be sorted by time
+	req.SortBy = constant.NotificationFieldCreatedAt
+	if req.Order == "" {
+		req.Order = constant.DESC
+	}
+}
+
+func (s *notificationServiceImpl) List(ctx context.Context, req *requests.ListNotificationsRequest, userID string) (*responses.ListNotificationsResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	normalizeListNotificationsPaginationRequest(&req.PaginationRequest)
+
+	notifications, totalNotification, err := s.notificationRepo.Search(ctx, &repositories.SearchNotificationRequest{
+		UserID:   bsonUserID,
+		IsUnread: req.IsUnread,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.ListNotificationsResponse{
+		Notifications: notifications,
+		UnreadCount:   unreadCount,
+		PaginationResponse: responses.PaginationResponse{
+			Page:      req.PaginationRequest.Page,
+			PageSize:  req.PaginationRequest.PageSize,
+			TotalPage: int(math.Ceil(float64(totalNotification) / float64(req.PaginationRequest.PageSize))),
+			TotalItem: int(totalNotification),
+		},
+	}, nil
+}
+
+func (s *notificationServiceImpl) MarkAsRead(ctx context.Context, req *requests.MarkNotificationAsReadRequest, userID string) (*models.Notification, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonNotificationID, err := bson.ObjectIDFromHex(req.NotificationID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrNotificationNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	notification, err := s.notificationRepo.MarkAsRead(ctx, bsonNotificationID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if notification == nil {
+		return nil, errutils.NewError(exceptions.ErrNotificationNotFound, errutils.NotFound).WithDebugMessage("notification not found")
+	}
+
+	return notification, nil
+}
+
+func (s *notificationServiceImpl) MarkAllAsRead(ctx context.Context, userID string) (*responses.MarkAllNotificationsAsReadResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	updatedCount, err := s.notificationRepo.MarkAllAsRead(ctx, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.MarkAllNotificationsAsReadResponse{
+		Message:      "Notifications marked as read",
+		UpdatedCount: updatedCount,
+	}, nil
+}
+
+// Subscribe returns the notifications pushed to the user from now on.
+// Only notifications published by this instance are delivered, clients still list them to catch up after reconnecting.
+func (s *notificationServiceImpl) Subscribe(ctx context.Context, userID string) (<-chan *models.Notification, func(), *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	notifications, unsubscribe := s.notificationPublisher.Subscribe(bsonUserID)
+
+	return notifications, unsubscribe, nil
+}
@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
+	"strings"
 
+	"github.com/cnc-csku/task-nexus-go-lib/utils/array"
 	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
 	"github.com/cnc-csku/task-nexus/task-management/config"
 	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
@@ -25,6 +29,10 @@ type ProjectService interface {
 	ListMembers(ctx context.Context, req *requests.ListProjectMembersRequest) (*responses.ListProjectMembersResponse, *errutils.Error)
 	AddWorkflows(ctx context.Context, req *requests.AddWorkflowsRequest, userID string) (*responses.AddWorkflowsResponse, *errutils.Error)
 	ListWorkflows(ctx context.Context, req *requests.ListWorkflowsPathParams) ([]models.Workflow, *errutils.Error)
+	UpdateWorkflow(ctx context.Context, req *requests.UpdateWorkflowRequest, userID string) (*responses.UpdateWorkflowResponse, *errutils.Error)
+	DeleteWorkflow(ctx context.Context, req *requests.DeleteWorkflowRequest, userID string) (*responses.DeleteWorkflowResponse, *errutils.Error)
+	SetDefaultWorkflow(ctx context.Context, req *requests.SetDefaultWorkflowRequest, userID string) (*responses.SetDefaultWorkflowResponse, *errutils.Error)
+	ReorderWorkflows(ctx context.Context, req *requests.ReorderWorkflowsRequest, userID string) (*responses.ReorderWorkflowsResponse, *errutils.Error)
 	AddAttributeTemplates(ctx context.Context, req *requests.AddAttributeTemplatesRequest, userID string) (*responses.AddAttributeTemplatesResponse, *errutils.Error)
 	ListAttributeTemplates(ctx context.Context, req *requests.ListAttributeTemplatesPathParams) ([]models.AttributeTemplate, *errutils.Error)
 }
@@ -35,6 +43,10 @@ type projectServiceImpl struct {
 	workspaceMemberRepo repositories.WorkspaceMemberRepository
 	projectRepo         repositories.ProjectRepository
 	projectMemberRepo   repositories.ProjectMemberRepository
+	taskRepo            repositories.TaskRepository
+	activityRepo        repositories.ActivityRepository
+	boardEventService   BoardEventService
+	unitOfWork          repositories.UnitOfWork
 	config              *config.Config
 }
 
@@ -44,6 +56,10 @@ func NewProjectService(
 	workspaceMemberRepo repositories.WorkspaceMemberRepository,
 	projectRepo repositories.ProjectRepository,
 	projectMemberRepo repositories.ProjectMemberRepository,
+	taskRepo repositories.TaskRepository,
+	activityRepo repositories.ActivityRepository,
+	boardEventService BoardEventService,
+	unitOfWork repositories.UnitOfWork,
 	config *config.Config,
 ) ProjectService {
 	return &projectServiceImpl{
@@ -52,6 +68,10 @@ func NewProjectService(
 		workspaceMemberRepo: workspaceMemberRepo,
 		projectRepo:         projectRepo,
 		projectMemberRepo:   projectMemberRepo,
+		taskRepo:            taskRepo,
+		activityRepo:        activityRepo,
+		boardEventService:   boardEventService,
+		unitOfWork:          unitOfWork,
 		config:              config,
 	}
 }
@@ -70,9 +90,9 @@ func (p *projectServiceImpl) Create(ctx context.Context, req *requests.CreatePro
 	member, err := p.workspaceMemberRepo.FindByWorkspaceIDAndUserID(ctx, bsonWorkspaceID, bsonUserId)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrMemberNotFoundInWorkspace, errutils.BadRequest)
-	} else if member.Role != models.WorkspaceMemberRoleOwner && member.Role != models.WorkspaceMemberRoleModerator {
+	} else if !member.Role.Can(models.PermissionProjectCreate) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
 	}
 
@@ -85,8 +105,8 @@ func (p *projectServiceImpl) Create(ctx context.Context, req *requests.CreatePro
 		return nil, errutils.NewError(exceptions.ErrProjectNameAlreadyExists, errutils.BadRequest)
 	}
 
-	// Check if project's prefix already exists
-	existsProjectByPrefix, err := p.projectRepo.FindByWorkspaceIDAndProjectPrefix(ctx, bsonWorkspaceID, req.ProjectPrefix)
+	// Check if project's prefix already exists, in any workspace since task IDs are looked up without one
+	existsProjectByPrefix, err := p.projectRepo.FindByProjectPrefix(ctx, req.ProjectPrefix)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
 	}
@@ -111,8 +131,15 @@ func (p *projectServiceImpl) Create(ctx context.Context, req *requests.CreatePro
 		CreatedBy:     bsonUserId,
 	}
 
-	createdProject, err := p.projectRepo.Create(ctx, project)
-	if err != nil {
+	// The project and its owner membership are written together
+	var createdProject *models.Project
+	err = p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		createdProject, err = p.projectRepo.Create(ctx, project)
+		return err
+	})
+	if errors.Is(err, repositories.ErrDuplicateKey) {
+		return nil, errutils.NewError(exceptions.ErrProjectPrefixAlreadyExists, errutils.BadRequest)
+	} else if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
 	}
 
@@ -220,7 +247,7 @@ func (p *projectServiceImpl) GetProjectDetail(ctx context.Context, req *requests
 	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
 	}
 
@@ -274,9 +301,9 @@ func (p *projectServiceImpl) AddPositions(ctx context.Context, req *requests.Add
 	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrUserNotFound, errutils.BadRequest)
-	} else if member.Role != models.ProjectMemberRoleOwner && member.Role != models.ProjectMemberRoleModerator {
+	} else if !member.Role.Can(models.PermissionProjectManage) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
 	}
 
@@ -309,6 +336,12 @@ func (p *projectServiceImpl) AddPositions(ctx context.Context, req *requests.Add
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
 	}
 
+	changes := activityChanges{}
+	changes.add("positions", existingPositions, append(existingPositions, newPositions...))
+	if serviceErr := p.recordProjectActivity(ctx, bsonProjectID, changes, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	return &responses.AddPositionsResponse{
 		Message: "Position added successfully",
 	}, nil
@@ -356,9 +389,9 @@ func (p *projectServiceImpl) AddMembers(ctx context.Context, req *requests.AddPr
 	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrUserNotFound, errutils.BadRequest)
-	} else if member.Role != models.ProjectMemberRoleOwner && member.Role != models.ProjectMemberRoleModerator {
+	} else if !member.Role.Can(models.PermissionProjectManage) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
 	}
 
@@ -397,6 +430,20 @@ func (p *projectServiceImpl) AddMembers(ctx context.Context, req *requests.AddPr
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
 	}
 
+	changes := activityChanges{}
+	for _, newMember := range createProjMemberReq {
+		changes.add("members", nil, models.ProjectMember{
+			UserID:   newMember.UserID,
+			Role:     newMember.Role,
+			Position: newMember.Position,
+		})
+	}
+	if len(changes) > 0 {
+		if serviceErr := p.recordProjectActivity(ctx, bsonProjectID, changes, bsonUserID); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
 	return nil, nil
 }
 
@@ -509,17 +556,14 @@ func (p *projectServiceImpl) AddWorkflows(ctx context.Context, req *requests.Add
 	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
-	} else if member.Role != models.ProjectMemberRoleOwner && member.Role != models.ProjectMemberRoleModerator {
+	} else if !member.Role.Can(models.PermissionProjectManage) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
 	}
 
 	// Check if the workflow already exists
-	existingWorkflows, err := p.projectRepo.FindWorkflowByProjectID(ctx, bsonProjectID)
-	if err != nil {
-		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	}
+	existingWorkflows := project.Workflows
 
 	workflowMap := make(map[string]struct{})
 	for _, workflow := range existingWorkflows {
@@ -529,10 +573,18 @@ func (p *projectServiceImpl) AddWorkflows(ctx context.Context, req *requests.Add
 	var newWorkflows []models.Workflow
 	for _, workflow := range req.Workflows {
 		if _, ok := workflowMap[workflow.Status]; !ok {
+			rules, serviceErr := buildWorkflowRules(project, workflow.Rules)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
 			newWorkflows = append(newWorkflows, models.Workflow{
 				Status:           workflow.Status,
 				PreviousStatuses: workflow.PreviousStatuses,
+				IsDone:           workflow.IsDone,
+				Rules:            rules,
 			})
+			workflowMap[workflow.Status] = struct{}{}
 		}
 	}
 
@@ -542,9 +594,16 @@ func (p *projectServiceImpl) AddWorkflows(ctx context.Context, req *requests.Add
 		}, nil
 	}
 
-	err = p.projectRepo.AddWorkflows(ctx, bsonProjectID, newWorkflows)
+	updatedWorkflows := append(cloneWorkflows(existingWorkflows), newWorkflows...)
+	if serviceErr := validateWorkflows(updatedWorkflows); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	err = p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return p.updateWorkflows(ctx, project, updatedWorkflows, bsonUserID)
+	})
 	if err != nil {
-		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
+		return nil, newWorkflowUpdateError(err)
 	}
 
 	return &responses.AddWorkflowsResponse{
@@ -573,6 +632,429 @@ func (p *projectServiceImpl) ListWorkflows(ctx context.Context, req *requests.Li
 	return workflows, nil
 }
 
+func (p *projectServiceImpl) UpdateWorkflow(ctx context.Context, req *requests.UpdateWorkflowRequest, userID string) (*responses.UpdateWorkflowResponse, *errutils.Error) {
+	project, bsonUserID, serviceErr := p.findProjectToManage(ctx, req.ProjectID, userID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	bsonProjectID, workflows := project.ID, project.Workflows
+
+	index := findWorkflowIndex(workflows, req.CurrentStatus)
+	if index < 0 {
+		return nil, errutils.NewError(exceptions.ErrWorkflowNotFound, errutils.NotFound).WithDebugMessage(fmt.Sprintf("Status not found in project workflows: %s", req.CurrentStatus))
+	}
+
+	updatedWorkflows := cloneWorkflows(workflows)
+	updatedWorkflows[index].Status = req.Status
+	if req.PreviousStatuses != nil {
+		updatedWorkflows[index].PreviousStatuses = *req.PreviousStatuses
+	}
+	if req.IsDone != nil {
+		updatedWorkflows[index].IsDone = *req.IsDone
+	}
+	if req.Rules != nil {
+		rules, serviceErr := buildWorkflowRules(project, req.Rules)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		updatedWorkflows[index].Rules = rules
+	}
+
+	isRenamed := req.Status != req.CurrentStatus
+	if isRenamed {
+		// Transitions out of the renamed status are kept under its new name
+		for i := range updatedWorkflows {
+			updatedWorkflows[i].PreviousStatuses = replaceWorkflowStatus(updatedWorkflows[i].PreviousStatuses, req.CurrentStatus, req.Status)
+		}
+	}
+
+	if serviceErr := validateWorkflows(updatedWorkflows); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	var updatedTaskCount int64
+	err := p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if isRenamed {
+			var err error
+			updatedTaskCount, err = p.taskRepo.ReplaceStatus(ctx, &repositories.ReplaceTaskStatusRequest{
+				ProjectID:  bsonProjectID,
+				FromStatus: req.CurrentStatus,
+				ToStatus:   req.Status,
+				UpdatedBy:  bsonUserID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return p.updateWorkflows(ctx, project, updatedWorkflows, bsonUserID)
+	})
+	if err != nil {
+		return nil, newWorkflowUpdateError(err)
+	}
+
+	p.boardEventService.Publish(ctx, models.BoardEventTypeWorkflowUpdated, bsonProjectID, updatedWorkflows, bsonUserID)
+
+	return &responses.UpdateWorkflowResponse{
+		Message:          "Workflow updated successfully",
+		UpdatedTaskCount: updatedTaskCount,
+	}, nil
+}
+
+func (p *projectServiceImpl) DeleteWorkflow(ctx context.Context, req *requests.DeleteWorkflowRequest, userID string) (*responses.DeleteWorkflowResponse, *errutils.Error) {
+	project, bsonUserID, serviceErr := p.findProjectToManage(ctx, req.ProjectID, userID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	bsonProjectID, workflows := project.ID, project.Workflows
+
+	index := findWorkflowIndex(workflows, req.Status)
+	if index < 0 {
+		return nil, errutils.NewError(exceptions.ErrWorkflowNotFound, errutils.NotFound).WithDebugMessage(fmt.Sprintf("Status not found in project workflows: %s", req.Status))
+	} else if workflows[index].IsDefault {
+		return nil, errutils.NewError(exceptions.ErrDeleteDefaultWorkflow, errutils.BadRequest)
+	}
+
+	if req.TargetStatus != "" && (req.TargetStatus == req.Status || findWorkflowIndex(workflows, req.TargetStatus) < 0) {
+		return nil, errutils.NewError(exceptions.ErrInvalidTargetStatus, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid target status: %s", req.TargetStatus))
+	}
+
+	updatedWorkflows := make([]models.Workflow, 0, len(workflows)-1)
+	for i, workflow := range workflows {
+		if i == index {
+			continue
+		}
+		workflow.PreviousStatuses = removeWorkflowStatus(workflow.PreviousStatuses, req.Status)
+		updatedWorkflows = append(updatedWorkflows, workflow)
+	}
+
+	if serviceErr := validateWorkflows(updatedWorkflows); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	// The tasks are checked in the same transaction as the workflow update, so a task moved into the status
+	// after it was read is either moved to the target status or stops the deletion
+	var movedTaskCount int64
+	err := p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if req.TargetStatus == "" {
+			taskCount, err := p.taskRepo.CountByStatus(ctx, bsonProjectID, req.Status)
+			if err != nil {
+				return err
+			} else if taskCount > 0 {
+				return errutils.NewError(exceptions.ErrTargetStatusRequired, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("%d tasks are in status %s", taskCount, req.Status))
+			}
+		} else {
+			var err error
+			movedTaskCount, err = p.taskRepo.ReplaceStatus(ctx, &repositories.ReplaceTaskStatusRequest{
+				ProjectID:      bsonProjectID,
+				FromStatus:     req.Status,
+				ToStatus:       req.TargetStatus,
+				ClearApprovals: true,
+				UpdatedBy:      bsonUserID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return p.updateWorkflows(ctx, project, updatedWorkflows, bsonUserID)
+	})
+	if err != nil {
+		return nil, newWorkflowUpdateError(err)
+	}
+
+	p.boardEventService.Publish(ctx, models.BoardEventTypeWorkflowUpdated, bsonProjectID, updatedWorkflows, bsonUserID)
+
+	return &responses.DeleteWorkflowResponse{
+		Message:        "Workflow deleted successfully",
+		MovedTaskCount: movedTaskCount,
+	}, nil
+}
+
+func (p *projectServiceImpl) SetDefaultWorkflow(ctx context.Context, req *requests.SetDefaultWorkflowRequest, userID string) (*responses.SetDefaultWorkflowResponse, *errutils.Error) {
+	project, bsonUserID, serviceErr := p.findProjectToManage(ctx, req.ProjectID, userID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	bsonProjectID, workflows := project.ID, project.Workflows
+
+	index := findWorkflowIndex(workflows, req.Status)
+	if index < 0 {
+		return nil, errutils.NewError(exceptions.ErrWorkflowNotFound, errutils.NotFound).WithDebugMessage(fmt.Sprintf("Status not found in project workflows: %s", req.Status))
+	} else if workflows[index].IsDefault {
+		return &responses.SetDefaultWorkflowResponse{
+			Message: "Workflow is already the default",
+		}, nil
+	}
+
+	updatedWorkflows := cloneWorkflows(workflows)
+	for i := range updatedWorkflows {
+		updatedWorkflows[i].IsDefault = i == index
+	}
+
+	if serviceErr := validateWorkflows(updatedWorkflows); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	err := p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return p.updateWorkflows(ctx, project, updatedWorkflows, bsonUserID)
+	})
+	if err != nil {
+		return nil, newWorkflowUpdateError(err)
+	}
+
+	p.boardEventService.Publish(ctx, models.BoardEventTypeWorkflowUpdated, bsonProjectID, updatedWorkflows, bsonUserID)
+
+	return &responses.SetDefaultWorkflowResponse{
+		Message: "Default workflow changed successfully",
+	}, nil
+}
+
+func (p *projectServiceImpl) ReorderWorkflows(ctx context.Context, req *requests.ReorderWorkflowsRequest, userID string) (*responses.ReorderWorkflowsResponse, *errutils.Error) {
+	project, bsonUserID, serviceErr := p.findProjectToManage(ctx, req.ProjectID, userID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	bsonProjectID, workflows := project.ID, project.Workflows
+
+	if len(req.Statuses) != len(workflows) {
+		return nil, errutils.NewError(exceptions.ErrInvalidWorkflowOrder, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Expected %d statuses, got %d", len(workflows), len(req.Statuses)))
+	}
+
+	workflowMap := make(map[string]models.Workflow, len(workflows))
+	for _, workflow := range workflows {
+		workflowMap[workflow.Status] = workflow
+	}
+
+	updatedWorkflows := make([]models.Workflow, 0, len(workflows))
+	for _, status := range req.Statuses {
+		workflow, ok := workflowMap[status]
+		if !ok {
+			return nil, errutils.NewError(exceptions.ErrInvalidWorkflowOrder, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Status is unknown or listed twice: %s", status))
+		}
+		updatedWorkflows = append(updatedWorkflows, workflow)
+		delete(workflowMap, status)
+	}
+
+	err := p.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		return p.updateWorkflows(ctx, project, updatedWorkflows, bsonUserID)
+	})
+	if err != nil {
+		return nil, newWorkflowUpdateError(err)
+	}
+
+	p.boardEventService.Publish(ctx, models.BoardEventTypeWorkflowUpdated, bsonProjectID, updatedWorkflows, bsonUserID)
+
+	return &responses.ReorderWorkflowsResponse{
+		Message: "Workflow reordered successfully",
+	}, nil
+}
+
+// findProjectToManage checks that the user can manage the project and returns the project
+func (p *projectServiceImpl) findProjectToManage(ctx context.Context, projectID string, userID string) (*models.Project, bson.ObjectID, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(projectID)
+	if err != nil {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	project, err := p.projectRepo.FindByProjectID(ctx, bsonProjectID)
+	if err != nil {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
+	} else if project == nil {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrProjectNotFound, errutils.NotFound)
+	}
+
+	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil || !member.Role.Can(models.PermissionProjectManage) {
+		return nil, bson.NilObjectID, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
+	}
+
+	return project, bsonUserID, nil
+}
+
+// updateWorkflows saves the workflows in place of the ones read with project and records the change,
+// it is meant to run inside a unit of work
+func (p *projectServiceImpl) updateWorkflows(ctx context.Context, project *models.Project, newWorkflows []models.Workflow, userID bson.ObjectID) error {
+	err := p.projectRepo.UpdateWorkflows(ctx, &repositories.UpdateProjectWorkflowsRequest{
+		ProjectID: project.ID,
+		Workflows: newWorkflows,
+		UpdatedBy: userID,
+		UpdatedAt: project.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	changes := activityChanges{}
+	changes.add("workflows", project.Workflows, newWorkflows)
+	if serviceErr := p.recordProjectActivity(ctx, project.ID, changes, userID); serviceErr != nil {
+		return serviceErr
+	}
+
+	return nil
+}
+
+// newWorkflowUpdateError keeps the errors raised inside the unit of work and reports concurrent edits as a conflict
+func newWorkflowUpdateError(err error) *errutils.Error {
+	var serviceErr *errutils.Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr
+	} else if errors.Is(err, repositories.ErrWriteConflict) {
+		return errutils.NewError(exceptions.ErrWorkflowConflict, errutils.Conflict)
+	}
+	return errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
+}
+
+// validateWorkflows checks that the statuses are unique, that exactly one of them is the default,
+// that every previous status exists and that every status can be reached from the default one.
+func validateWorkflows(workflows []models.Workflow) *errutils.Error {
+	statuses := make(map[string]bool, len(workflows))
+	defaultStatus := ""
+	for _, workflow := range workflows {
+		if strings.TrimSpace(workflow.Status) == "" {
+			return errutils.NewError(exceptions.ErrInvalidWorkflowStatus, errutils.BadRequest)
+		} else if statuses[workflow.Status] {
+			return errutils.NewError(exceptions.ErrWorkflowAlreadyExists, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Duplicated status: %s", workflow.Status))
+		}
+		statuses[workflow.Status] = true
+
+		if workflow.IsDefault {
+			if defaultStatus != "" {
+				return errutils.NewError(exceptions.ErrMultipleDefaultWorkflows, errutils.BadRequest)
+			}
+			defaultStatus = workflow.Status
+		}
+	}
+
+	if defaultStatus == "" {
+		return errutils.NewError(exceptions.ErrDefaultWorkflowNotFound, errutils.BadRequest)
+	}
+
+	nextStatuses := make(map[string][]string, len(workflows))
+	for _, workflow := range workflows {
+		for _, previousStatus := range workflow.PreviousStatuses {
+			if !statuses[previousStatus] {
+				return errutils.NewError(exceptions.ErrPreviousStatusNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Status %s lists unknown previous status %s", workflow.Status, previousStatus))
+			}
+			nextStatuses[previousStatus] = append(nextStatuses[previousStatus], workflow.Status)
+		}
+	}
+
+	// Walk the transitions from the default status, tasks start there so anything not visited could never be used
+	reachable := map[string]bool{defaultStatus: true}
+	queue := []string{defaultStatus}
+	for len(queue) > 0 {
+		status := queue[0]
+		queue = queue[1:]
+
+		for _, nextStatus := range nextStatuses[status] {
+			if !reachable[nextStatus] {
+				reachable[nextStatus] = true
+				queue = append(queue, nextStatus)
+			}
+		}
+	}
+
+	unreachableStatuses := make([]string, 0)
+	for _, workflow := range workflows {
+		if !reachable[workflow.Status] {
+			unreachableStatuses = append(unreachableStatuses, workflow.Status)
+		}
+	}
+	if len(unreachableStatuses) > 0 {
+		return errutils.NewError(exceptions.ErrUnreachableWorkflow, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Unreachable statuses: %s", strings.Join(unreachableStatuses, ", ")))
+	}
+
+	return nil
+}
+
+// buildWorkflowRules checks that the rules only use the project's attributes and positions, an empty request means no rules
+func buildWorkflowRules(project *models.Project, reqRules *requests.WorkflowRulesRequest) (*models.WorkflowRules, *errutils.Error) {
+	if reqRules == nil {
+		return nil, nil
+	}
+
+	attributeNames := make([]string, 0, len(project.AttributeTemplates))
+	for _, template := range project.AttributeTemplates {
+		attributeNames = append(attributeNames, template.Name)
+	}
+	for _, attribute := range reqRules.RequiredAttributes {
+		if !array.ContainAny(attributeNames, []string{attribute}) {
+			return nil, errutils.NewError(exceptions.ErrAttributeNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Attribute not found: %s", attribute))
+		}
+	}
+
+	for _, position := range reqRules.AllowedPositions {
+		if !array.ContainAny(project.Positions, []string{position}) {
+			return nil, errutils.NewError(exceptions.ErrPositionNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Position not found: %s", position))
+		}
+	}
+
+	allowedRoles := make([]models.ProjectMemberRole, 0, len(reqRules.AllowedRoles))
+	for _, role := range reqRules.AllowedRoles {
+		allowedRoles = append(allowedRoles, models.ProjectMemberRole(role))
+	}
+
+	rules := &models.WorkflowRules{
+		RequiredAttributes: reqRules.RequiredAttributes,
+		RequiredApprovals:  reqRules.RequiredApprovals,
+		AllowedRoles:       allowedRoles,
+		AllowedPositions:   reqRules.AllowedPositions,
+		AssigneeOnly:       reqRules.AssigneeOnly,
+	}
+	if len(rules.RequiredAttributes) == 0 && rules.RequiredApprovals == 0 && len(rules.AllowedRoles) == 0 && len(rules.AllowedPositions) == 0 && !rules.AssigneeOnly {
+		return nil, nil
+	}
+
+	return rules, nil
+}
+
+func findWorkflowIndex(workflows []models.Workflow, status string) int {
+	for i, workflow := range workflows {
+		if workflow.Status == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// cloneWorkflows copies the list so the original can still be recorded as the old value
+func cloneWorkflows(workflows []models.Workflow) []models.Workflow {
+	clonedWorkflows := make([]models.Workflow, len(workflows))
+	copy(clonedWorkflows, workflows)
+	return clonedWorkflows
+}
+
+func replaceWorkflowStatus(statuses []string, oldStatus string, newStatus string) []string {
+	replacedStatuses := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		if status == oldStatus {
+			status = newStatus
+		}
+		if !array.ContainAny(replacedStatuses, []string{status}) {
+			replacedStatuses = append(replacedStatuses, status)
+		}
+	}
+	return replacedStatuses
+}
+
+func removeWorkflowStatus(statuses []string, removedStatus string) []string {
+	remainingStatuses := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		if status != removedStatus {
+			remainingStatuses = append(remainingStatuses, status)
+		}
+	}
+	return remainingStatuses
+}
+
 func (p *projectServiceImpl) AddAttributeTemplates(ctx context.Context, req *requests.AddAttributeTemplatesRequest, userID string) (*responses.AddAttributeTemplatesResponse, *errutils.Error) {
 	bsonUserID, err := bson.ObjectIDFromHex(userID)
 	if err != nil {
@@ -601,9 +1083,9 @@ func (p *projectServiceImpl) AddAttributeTemplates(ctx context.Context, req *req
 	member, err := p.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
-	} else if member.Role != models.ProjectMemberRoleOwner && member.Role != models.ProjectMemberRoleModerator {
+	} else if !member.Role.Can(models.PermissionProjectManage) {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
 	}
 
@@ -639,6 +1121,12 @@ func (p *projectServiceImpl) AddAttributeTemplates(ctx context.Context, req *req
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
 	}
 
+	changes := activityChanges{}
+	changes.add("attribute_templates", existingAttributeTemplates, append(existingAttributeTemplates, newAttributeTemplates...))
+	if serviceErr := p.recordProjectActivity(ctx, bsonProjectID, changes, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	return &responses.AddAttributeTemplatesResponse{
 		Message: "Attribute template added successfully",
 	}, nil
@@ -664,3 +1152,19 @@ func (p *projectServiceImpl) ListAttributeTemplates(ctx context.Context, req *re
 
 	return attributeTemplates, nil
 }
+
+func (p *projectServiceImpl) recordProjectActivity(ctx context.Context, projectID bson.ObjectID, changes []models.ActivityChange, userID bson.ObjectID) *errutils.Error {
+	err := p.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  projectID,
+		EntityType: models.ActivityEntityTypeProject,
+		EntityID:   projectID.Hex(),
+		Action:     models.ActivityActionUpdated,
+		Changes:    changes,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		return errutils.NewError(exceptions.ErrInternalError, errutils.InternalError).WithDebugMessage(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fakeWorkflowProjectRepo holds one project and, like the mongo repository, rejects workflows based on an older read
+type fakeWorkflowProjectRepo struct {
+	repositories.ProjectRepository
+	project *models.Project
+	saved   *repositories.UpdateProjectWorkflowsRequest
+}
+
+func (f *fakeWorkflowProjectRepo) FindByProjectID(ctx context.Context, projectID bson.ObjectID) (*models.Project, error) {
+	if projectID != f.project.ID {
+		return nil, nil
+	}
+	project := *f.project
+	return &project, nil
+}
+
+func (f *fakeWorkflowProjectRepo) FindWorkflowByProjectID(ctx context.Context, projectID bson.ObjectID) ([]models.Workflow, error) {
+	if projectID != f.project.ID {
+		return nil, nil
+	}
+	return f.project.Workflows, nil
+}
+
+func (f *fakeWorkflowProjectRepo) UpdateWorkflows(ctx context.Context, in *repositories.UpdateProjectWorkflowsRequest) error {
+	if !in.UpdatedAt.Equal(f.project.UpdatedAt) {
+		return repositories.ErrWriteConflict
+	}
+	f.saved = in
+	f.project.Workflows = in.Workflows
+	f.project.UpdatedAt = f.project.UpdatedAt.Add(time.Second)
+	return nil
+}
+
+// fakeWorkflowTaskRepo counts the tasks of each status
+type fakeWorkflowTaskRepo struct {
+	repositories.TaskRepository
+	counts   map[string]int64
+	replaced []*repositories.ReplaceTaskStatusRequest
+}
+
+func (f *fakeWorkflowTaskRepo) CountByStatus(ctx context.Context, projectID bson.ObjectID, status string) (int64, error) {
+	return f.counts[status], nil
+}
+
+func (f *fakeWorkflowTaskRepo) ReplaceStatus(ctx context.Context, in *repositories.ReplaceTaskStatusRequest) (int64, error) {
+	f.replaced = append(f.replaced, in)
+
+	moved := f.counts[in.FromStatus]
+	f.counts[in.ToStatus] += moved
+	delete(f.counts, in.FromStatus)
+	return moved, nil
+}
+
+type fakeActivityRepo struct {
+	repositories.ActivityRepository
+}
+
+func (f *fakeActivityRepo) Create(ctx context.Context, in *repositories.CreateActivityRequest) error {
+	return nil
+}
+
+type fakeBoardEventService struct {
+	BoardEventService
+}
+
+func (f *fakeBoardEventService) Publish(ctx context.Context, eventType models.BoardEventType, projectID bson.ObjectID, payload interface{}, actorID bson.ObjectID) {
+}
+
+// fakeUnitOfWork runs the function without a transaction
+type fakeUnitOfWork struct{}
+
+func (f *fakeUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// newWorkflowTestService returns a service managing a TODO -> IN_PROGRESS -> DONE project, DONE can also be reached from TODO
+func newWorkflowTestService(counts map[string]int64) (*projectServiceImpl, *fakeWorkflowProjectRepo, *fakeWorkflowTaskRepo, bson.ObjectID) {
+	userID := bson.NewObjectID()
+	projectRepo := &fakeWorkflowProjectRepo{project: &models.Project{
+		ID: bson.NewObjectID(),
+		Workflows: []models.Workflow{
+			{Status: "TODO", IsDefault: true, PreviousStatuses: []string{}},
+			{Status: "IN_PROGRESS", PreviousStatuses: []string{"TODO"}},
+			{Status: "DONE", PreviousStatuses: []string{"TODO", "IN_PROGRESS"}, IsDone: true},
+		},
+		UpdatedAt: time.Now(),
+	}}
+	taskRepo := &fakeWorkflowTaskRepo{counts: counts}
+
+	service := &projectServiceImpl{
+		projectRepo: projectRepo,
+		projectMemberRepo: &fakeTaskMemberRepo{members: map[bson.ObjectID]*models.ProjectMember{
+			userID: {UserID: userID, Role: models.ProjectMemberRoleOwner},
+		}},
+		taskRepo:          taskRepo,
+		activityRepo:      &fakeActivityRepo{},
+		boardEventService: &fakeBoardEventService{},
+		unitOfWork:        &fakeUnitOfWork{},
+	}
+
+	return service, projectRepo, taskRepo, userID
+}
+
+func TestValidateWorkflows(t *testing.T) {
+	tests := []struct {
+		name      string
+		workflows []models.Workflow
+		wantErr   error
+	}{
+		{
+			name: "valid",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: "DONE", PreviousStatuses: []string{"TODO"}},
+			},
+		},
+		{
+			name: "empty status",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: " ", PreviousStatuses: []string{"TODO"}},
+			},
+			wantErr: exceptions.ErrInvalidWorkflowStatus,
+		},
+		{
+			name: "duplicated status",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: "TODO"},
+			},
+			wantErr: exceptions.ErrWorkflowAlreadyExists,
+		},
+		{
+			name: "no default",
+			workflows: []models.Workflow{
+				{Status: "TODO"},
+			},
+			wantErr: exceptions.ErrDefaultWorkflowNotFound,
+		},
+		{
+			name: "two defaults",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: "DONE", IsDefault: true},
+			},
+			wantErr: exceptions.ErrMultipleDefaultWorkflows,
+		},
+		{
+			name: "unknown previous status",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: "DONE", PreviousStatuses: []string{"REVIEW"}},
+			},
+			wantErr: exceptions.ErrPreviousStatusNotFound,
+		},
+		{
+			name: "status only reachable from an unreachable one",
+			workflows: []models.Workflow{
+				{Status: "TODO", IsDefault: true},
+				{Status: "REVIEW", PreviousStatuses: []string{"DONE"}},
+				{Status: "DONE", PreviousStatuses: []string{"REVIEW"}},
+			},
+			wantErr: exceptions.ErrUnreachableWorkflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWorkflows(tt.workflows)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("validateWorkflows() error = %v", err)
+				}
+				return
+			}
+			assertServiceError(t, err, tt.wantErr, errutils.BadRequest)
+		})
+	}
+}
+
+func TestUpdateWorkflowRenamesStatus(t *testing.T) {
+	service, projectRepo, taskRepo, userID := newWorkflowTestService(map[string]int64{"IN_PROGRESS": 2})
+	readAt := projectRepo.project.UpdatedAt
+
+	res, err := service.UpdateWorkflow(context.Background(), &requests.UpdateWorkflowRequest{
+		ProjectID:     projectRepo.project.ID.Hex(),
+		CurrentStatus: "IN_PROGRESS",
+		Status:        "DOING",
+	}, userID.Hex())
+	if err != nil {
+		t.Fatalf("UpdateWorkflow() error = %v", err)
+	}
+
+	if res.UpdatedTaskCount != 2 || len(taskRepo.replaced) != 1 || taskRepo.replaced[0].FromStatus != "IN_PROGRESS" || taskRepo.replaced[0].ToStatus != "DOING" {
+		t.Fatalf("tasks were not moved along with the rename: %d, %+v", res.UpdatedTaskCount, taskRepo.replaced)
+	}
+	if taskRepo.replaced[0].ClearApprovals {
+		t.Fatal("approvals were cleared although the tasks kept their status under a new name")
+	}
+	if !projectRepo.saved.UpdatedAt.Equal(readAt) {
+		t.Fatalf("workflows were saved against updated_at %s, want %s", projectRepo.saved.UpdatedAt, readAt)
+	}
+	if got := projectRepo.saved.Workflows[2].PreviousStatuses; !reflect.DeepEqual(got, []string{"TODO", "DOING"}) {
+		t.Fatalf("previous statuses of DONE = %v, want [TODO DOING]", got)
+	}
+}
+
+func TestDeleteWorkflow(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		targetStatus string
+		counts       map[string]int64
+		wantErr      error
+		wantStatus   errutils.ErrorStatus
+		wantMoved    int64
+	}{
+		{
+			name:   "no tasks left in the status",
+			status: "IN_PROGRESS",
+			counts: map[string]int64{"TODO": 1},
+		},
+		{
+			name:         "tasks moved to the target status",
+			status:       "IN_PROGRESS",
+			targetStatus: "TODO",
+			counts:       map[string]int64{"IN_PROGRESS": 3},
+			wantMoved:    3,
+		},
+		{
+			name:       "tasks without a target status",
+			status:     "IN_PROGRESS",
+			counts:     map[string]int64{"IN_PROGRESS": 3},
+			wantErr:    exceptions.ErrTargetStatusRequired,
+			wantStatus: errutils.BadRequest,
+		},
+		{
+			name:         "target status is the deleted status",
+			status:       "IN_PROGRESS",
+			targetStatus: "IN_PROGRESS",
+			counts:       map[string]int64{},
+			wantErr:      exceptions.ErrInvalidTargetStatus,
+			wantStatus:   errutils.BadRequest,
+		},
+		{
+			name:       "default status",
+			status:     "TODO",
+			counts:     map[string]int64{},
+			wantErr:    exceptions.ErrDeleteDefaultWorkflow,
+			wantStatus: errutils.BadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, projectRepo, taskRepo, userID := newWorkflowTestService(tt.counts)
+
+			res, err := service.DeleteWorkflow(context.Background(), &requests.DeleteWorkflowRequest{
+				ProjectID:    projectRepo.project.ID.Hex(),
+				Status:       tt.status,
+				TargetStatus: tt.targetStatus,
+			}, userID.Hex())
+
+			if tt.wantErr != nil {
+				assertServiceError(t, err, tt.wantErr, tt.wantStatus)
+				if projectRepo.saved != nil {
+					t.Fatalf("workflows were saved: %+v", projectRepo.saved.Workflows)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DeleteWorkflow() error = %v", err)
+			}
+
+			if res.MovedTaskCount != tt.wantMoved || taskRepo.counts[tt.status] != 0 {
+				t.Fatalf("moved %d tasks, %d left in %s, want %d moved", res.MovedTaskCount, taskRepo.counts[tt.status], tt.status, tt.wantMoved)
+			}
+			for _, replaced := range taskRepo.replaced {
+				if !replaced.ClearApprovals {
+					t.Fatalf("approvals given for %s were kept after moving the tasks to %s", replaced.FromStatus, replaced.ToStatus)
+				}
+			}
+			if got := projectRepo.saved.Workflows; len(got) != 2 || !reflect.DeepEqual(got[1].PreviousStatuses, []string{"TODO"}) {
+				t.Fatalf("saved workflows = %+v, want TODO and DONE reachable from TODO only", got)
+			}
+		})
+	}
+}
+
+func TestUpdateWorkflowRejectsConcurrentEdits(t *testing.T) {
+	service, projectRepo, _, userID := newWorkflowTestService(map[string]int64{})
+
+	// Another edit was saved after this request read the project
+	project := *projectRepo.project
+	projectRepo.project.UpdatedAt = projectRepo.project.UpdatedAt.Add(time.Second)
+
+	err := service.updateWorkflows(context.Background(), &project, project.Workflows, userID)
+	assertServiceError(t, newWorkflowUpdateError(err), exceptions.ErrWorkflowConflict, errutils.Conflict)
+}
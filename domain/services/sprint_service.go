@@ -2,9 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"math"
+	"time"
 
 	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
@@ -16,23 +22,45 @@ type SprintService interface {
 	Create(ctx context.Context, req *requests.CreateSprintRequest, userID string) (*responses.CreateSprintResponse, *errutils.Error)
 	GetByID(ctx context.Context, req *requests.GetSprintByIDRequest) (*models.Sprint, *errutils.Error)
 	Edit(ctx context.Context, req *requests.EditSprintRequest, userID string) (*responses.EditSprintResponse, *errutils.Error)
+	Start(ctx context.Context, req *requests.StartSprintRequest, userID string) (*responses.StartSprintResponse, *errutils.Error)
+	Complete(ctx context.Context, req *requests.CompleteSprintRequest, userID string) (*responses.CompleteSprintResponse, *errutils.Error)
+	List(ctx context.Context, req *requests.ListSprintsRequest, userID string) (*responses.ListSprintsResponse, *errutils.Error)
+	MoveTasks(ctx context.Context, req *requests.MoveTasksToSprintRequest, userID string) (*responses.MoveTasksToSprintResponse, *errutils.Error)
 }
 
 type sprintServiceImpl struct {
-	sprintRepo  repositories.SprintRepository
-	projectRepo repositories.ProjectRepository
-	// runningNumberRepo repositories.RunningNumberRepository
+	sprintRepo          repositories.SprintRepository
+	projectRepo         repositories.ProjectRepository
+	projectMemberRepo   repositories.ProjectMemberRepository
+	taskRepo            repositories.TaskRepository
+	activityRepo        repositories.ActivityRepository
+	notificationService NotificationService
+	boardEventService   BoardEventService
+	webhookService      WebhookService
+	unitOfWork          repositories.UnitOfWork
 }
 
 func NewSprintService(
 	sprintRepo repositories.SprintRepository,
 	projectRepo repositories.ProjectRepository,
-	// runningNumberRepo repositories.RunningNumberRepository,
+	projectMemberRepo repositories.ProjectMemberRepository,
+	taskRepo repositories.TaskRepository,
+	activityRepo repositories.ActivityRepository,
+	notificationService NotificationService,
+	boardEventService BoardEventService,
+	webhookService WebhookService,
+	unitOfWork repositories.UnitOfWork,
 ) SprintService {
 	return &sprintServiceImpl{
-		sprintRepo:  sprintRepo,
-		projectRepo: projectRepo,
-		// runningNumberRepo: runningNumberRepo,
+		sprintRepo:          sprintRepo,
+		projectRepo:         projectRepo,
+		projectMemberRepo:   projectMemberRepo,
+		taskRepo:            taskRepo,
+		activityRepo:        activityRepo,
+		notificationService: notificationService,
+		boardEventService:   boardEventService,
+		webhookService:      webhookService,
+		unitOfWork:          unitOfWork,
 	}
 }
 
@@ -53,19 +81,28 @@ func (s *sprintServiceImpl) Create(ctx context.Context, req *requests.CreateSpri
 		return nil, errutils.NewError(fmt.Errorf("project not found"), errutils.NotFound).WithDebugMessage("project not found")
 	}
 
-	// should be in transaction, to be implemented
-	sprint := &repositories.CreateSprintRequest{
-		ProjectID: bsonProjectID,
-		Title:     fmt.Sprintf("Sprint %d", project.SprintRunningNumber),
-		CreatedBy: bsonUserID,
-	}
+	var createdSprint *models.Sprint
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		sprintRunningNumber, err := s.projectRepo.NextSprintRunningNumber(ctx, bsonProjectID)
+		if err != nil {
+			return err
+		}
 
-	createdSprint, err := s.sprintRepo.Create(ctx, sprint)
-	if err != nil {
-		return nil, errutils.NewError(err, errutils.InternalServerError).WithDebugMessage(err.Error())
-	}
+		createdSprint, err = s.sprintRepo.Create(ctx, &repositories.CreateSprintRequest{
+			ProjectID: bsonProjectID,
+			Title:     fmt.Sprintf("Sprint %d", sprintRunningNumber),
+			CreatedBy: bsonUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if serviceErr := s.recordSprintActivity(ctx, createdSprint, models.ActivityActionCreated, nil, bsonUserID); serviceErr != nil {
+			return serviceErr
+		}
 
-	err = s.projectRepo.IncrementSprintRunningNumber(ctx, bsonProjectID)
+		return nil
+	})
 	if err != nil {
 		return nil, errutils.NewError(err, errutils.InternalServerError).WithDebugMessage(err.Error())
 	}
@@ -80,16 +117,22 @@ func (s *sprintServiceImpl) Create(ctx context.Context, req *requests.CreateSpri
 }
 
 func (s *sprintServiceImpl) GetByID(ctx context.Context, req *requests.GetSprintByIDRequest) (*models.Sprint, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(err, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
 	bsonSprintID, err := bson.ObjectIDFromHex(req.SprintID)
 	if err != nil {
 		return nil, errutils.NewError(err, errutils.BadRequest).WithDebugMessage(err.Error())
 	}
 
+	// Permissions are checked on the project in the path, a sprint of another project must look missing
 	sprint, err := s.sprintRepo.FindByID(ctx, bsonSprintID)
 	if err != nil {
 		return nil, errutils.NewError(err, errutils.InternalServerError).WithDebugMessage(err.Error())
-	} else if sprint == nil {
-		return nil, errutils.NewError(fmt.Errorf("sprint not found"), errutils.NotFound).WithDebugMessage("sprint not found")
+	} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
 	}
 
 	return sprint, nil
@@ -104,6 +147,19 @@ func (s *sprintServiceImpl) Edit(ctx context.Context, req *requests.EditSprintRe
 	if err != nil {
 		return nil, errutils.NewError(err, errutils.BadRequest).WithDebugMessage(err.Error())
 	}
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(err, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	sprint, err := s.sprintRepo.FindByID(ctx, bsonSprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
+	} else if sprint.Status == models.SprintStatusCompleted {
+		return nil, errutils.NewError(exceptions.ErrSprintAlreadyComplete, errutils.BadRequest)
+	}
 
 	var (
 		startDate = req.StartDate
@@ -131,7 +187,513 @@ func (s *sprintServiceImpl) Edit(ctx context.Context, req *requests.EditSprintRe
 		return nil, errutils.NewError(err, errutils.InternalServerError).WithDebugMessage(err.Error())
 	}
 
+	changes := activityChanges{}
+	changes.add("title", sprint.Title, req.Title)
+	changes.add("sprint_goal", sprint.SprintGoal, req.SprintGoal)
+	changes.add("start_date", sprint.StartDate, startDate)
+	changes.add("end_date", sprint.EndDate, endDate)
+	if len(changes) > 0 {
+		if serviceErr := s.recordSprintActivity(ctx, sprint, models.ActivityActionUpdated, changes, bsonUserID); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
+	s.publishSprintUpdated(ctx, sprint.ID, bsonUserID)
+
 	return &responses.EditSprintResponse{
 		Message: "Sprint updated successfully",
 	}, nil
 }
+
+func (s *sprintServiceImpl) Start(ctx context.Context, req *requests.StartSprintRequest, userID string) (*responses.StartSprintResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonSprintID, err := bson.ObjectIDFromHex(req.SprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	// Check if the user is owner or moderator of the project
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	} else if !member.Role.Can(models.PermissionSprintEdit) {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
+	}
+
+	sprint, err := s.sprintRepo.FindByID(ctx, bsonSprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
+	}
+
+	if !isSprintPlanned(sprint) {
+		return nil, errutils.NewError(exceptions.ErrSprintNotPlanned, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Sprint status: %s", sprint.Status))
+	}
+
+	activeSprints, err := s.sprintRepo.FindByProjectIDAndStatus(ctx, bsonProjectID, models.SprintStatusActive)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if len(activeSprints) > 0 {
+		return nil, errutils.NewError(exceptions.ErrSprintAlreadyActive, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Active sprint: %s", activeSprints[0].Title))
+	}
+
+	startDate := sprint.StartDate
+	if startDate == nil {
+		now := time.Now()
+		startDate = &now
+	}
+
+	err = s.sprintRepo.UpdateStatus(ctx, &repositories.UpdateSprintStatusRequest{
+		ID:        bsonSprintID,
+		Status:    models.SprintStatusActive,
+		StartDate: startDate,
+		EndDate:   sprint.EndDate,
+		UpdatedBy: bsonUserID,
+	})
+	if errors.Is(err, repositories.ErrDuplicateKey) {
+		// another sprint was started after the check above
+		return nil, errutils.NewError(exceptions.ErrSprintAlreadyActive, errutils.BadRequest)
+	} else if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	changes := activityChanges{}
+	changes.add("status", sprint.Status, models.SprintStatusActive)
+	changes.add("start_date", sprint.StartDate, startDate)
+	if serviceErr := s.recordSprintActivity(ctx, sprint, models.ActivityActionUpdated, changes, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	s.notifyProjectMembers(ctx, sprint, models.NotificationTypeSprintStarted, fmt.Sprintf("Sprint %s has started", sprint.Title), bsonUserID)
+
+	s.publishSprintUpdated(ctx, sprint.ID, bsonUserID, models.WebhookEventSprintStarted)
+
+	return &responses.StartSprintResponse{
+		Message: "Sprint started successfully",
+	}, nil
+}
+
+func (s *sprintServiceImpl) Complete(ctx context.Context, req *requests.CompleteSprintRequest, userID string) (*responses.CompleteSprintResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonSprintID, err := bson.ObjectIDFromHex(req.SprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	// Check if the user is owner or moderator of the project
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	} else if !member.Role.Can(models.PermissionSprintEdit) {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest)
+	}
+
+	sprint, err := s.sprintRepo.FindByID(ctx, bsonSprintID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
+	}
+
+	if sprint.Status != models.SprintStatusActive {
+		return nil, errutils.NewError(exceptions.ErrSprintNotActive, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Sprint status: %s", sprint.Status))
+	}
+
+	// Unfinished tasks go to the next sprint, or to the backlog when no next sprint is given
+	var bsonNextSprintID *bson.ObjectID
+	if req.NextSprintID != nil {
+		nextSprintID, err := bson.ObjectIDFromHex(*req.NextSprintID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+
+		nextSprint, err := s.sprintRepo.FindByID(ctx, nextSprintID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		} else if nextSprint == nil || nextSprint.ProjectID != bsonProjectID {
+			return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Next sprint not found: %s", *req.NextSprintID))
+		} else if nextSprint.ID == sprint.ID || nextSprint.Status == models.SprintStatusCompleted {
+			return nil, errutils.NewError(exceptions.ErrInvalidNextSprint, errutils.BadRequest).WithDebugMessage("Next sprint must be another sprint that is not completed")
+		}
+
+		bsonNextSprintID = &nextSprintID
+	}
+
+	workflows, err := s.projectRepo.FindWorkflowByProjectID(ctx, bsonProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	doneStatuses := models.GetDoneStatuses(workflows)
+
+	endDate := sprint.EndDate
+	if endDate == nil {
+		now := time.Now()
+		endDate = &now
+	}
+
+	// Tasks are carried over and the sprint is completed together, so a retry never carries tasks over twice
+	var carriedOverTasks []*models.Task
+	var carriedOverCount int64
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		carriedOverTasks, err = s.taskRepo.FindBySprintID(ctx, bsonProjectID, bsonSprintID, doneStatuses)
+		if err != nil {
+			return err
+		}
+
+		carriedOverCount, err = s.taskRepo.CarryOverSprintTasks(ctx, &repositories.CarryOverSprintTasksRequest{
+			ProjectID:       bsonProjectID,
+			FromSprintID:    bsonSprintID,
+			ToSprintID:      bsonNextSprintID,
+			ExcludeStatuses: doneStatuses,
+			UpdatedBy:       bsonUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.activityRepo.CreateMany(ctx, newTaskSprintActivities(carriedOverTasks, bsonNextSprintID, bsonUserID))
+		if err != nil {
+			return err
+		}
+
+		err = s.sprintRepo.UpdateStatus(ctx, &repositories.UpdateSprintStatusRequest{
+			ID:        bsonSprintID,
+			Status:    models.SprintStatusCompleted,
+			StartDate: sprint.StartDate,
+			EndDate:   endDate,
+			UpdatedBy: bsonUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		changes := activityChanges{}
+		changes.add("status", sprint.Status, models.SprintStatusCompleted)
+		changes.add("end_date", sprint.EndDate, endDate)
+		if serviceErr := s.recordSprintActivity(ctx, sprint, models.ActivityActionUpdated, changes, bsonUserID); serviceErr != nil {
+			return serviceErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	s.notifyProjectMembers(ctx, sprint, models.NotificationTypeSprintCompleted, fmt.Sprintf("Sprint %s has been completed", sprint.Title), bsonUserID)
+
+	s.publishSprintUpdated(ctx, sprint.ID, bsonUserID, models.WebhookEventSprintCompleted)
+
+	carriedOverTaskIDs := make([]string, 0, len(carriedOverTasks))
+	for _, task := range carriedOverTasks {
+		carriedOverTaskIDs = append(carriedOverTaskIDs, task.TaskID)
+	}
+	s.publishTasksUpdated(ctx, bsonProjectID, carriedOverTaskIDs, bsonUserID)
+
+	return &responses.CompleteSprintResponse{
+		Message:              "Sprint completed successfully",
+		CarriedOverTaskCount: carriedOverCount,
+	}, nil
+}
+
+func validateListSprintsPaginationRequestSortBy(sortBy string) bool {
+	switch sortBy {
+	case constant.SprintFieldTitle, constant.SprintFieldStartDate, constant.SprintFieldEndDate, constant.SprintFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func normalizeListSprintsPaginationRequest(req *requests.ListSprintsRequest) {
+	if req.PaginationRequest.Page <= 0 {
+		req.PaginationRequest.Page = 1
+	}
+	if req.PaginationRequest.PageSize <= 0 {
+		req.PaginationRequest.PageSize = 100
+	}
+	if req.PaginationRequest.SortBy == "" || !validateListSprintsPaginationRequestSortBy(req.PaginationRequest.SortBy) {
+		req.PaginationRequest.SortBy = constant.SprintFieldCreatedAt
+	}
+	if req.PaginationRequest.Order == "" {
+		req.PaginationRequest.Order = constant.DESC
+	}
+}
+
+// parseSprintDateQuery accepts either an RFC3339 timestamp or a plain date
+func parseSprintDateQuery(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(constant.TimeFormat, value)
+	if err != nil {
+		date, err = time.Parse(constant.DateFormat, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &date, nil
+}
+
+func (s *sprintServiceImpl) List(ctx context.Context, req *requests.ListSprintsRequest, userID string) (*responses.ListSprintsResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	status := models.SprintStatus(req.Status)
+	if status != "" && !status.IsValid() {
+		return nil, errutils.NewError(exceptions.ErrInvalidSprintStatus, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid sprint status: %s", req.Status))
+	}
+
+	startDateFrom, err := parseSprintDateQuery(req.StartDateFrom)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidSprintDate, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	endDateTo, err := parseSprintDateQuery(req.EndDateTo)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidSprintDate, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	normalizeListSprintsPaginationRequest(req)
+
+	sprints, totalSprint, err := s.sprintRepo.Search(ctx, &repositories.SearchSprintRequest{
+		ProjectID:     bsonProjectID,
+		Status:        status,
+		StartDateFrom: startDateFrom,
+		EndDateTo:     endDateTo,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.ListSprintsResponse{
+		Sprints: sprints,
+		PaginationResponse: responses.PaginationResponse{
+			Page:      req.PaginationRequest.Page,
+			PageSize:  req.PaginationRequest.PageSize,
+			TotalPage: int(math.Ceil(float64(totalSprint) / float64(req.PaginationRequest.PageSize))),
+			TotalItem: int(totalSprint),
+		},
+	}, nil
+}
+
+func (s *sprintServiceImpl) MoveTasks(ctx context.Context, req *requests.MoveTasksToSprintRequest, userID string) (*responses.MoveTasksToSprintResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	// A nil sprint ID moves the tasks back to the backlog
+	var bsonSprintID *bson.ObjectID
+	if req.SprintID != nil {
+		sprintID, err := bson.ObjectIDFromHex(*req.SprintID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+
+		sprint, err := s.sprintRepo.FindByID(ctx, sprintID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		} else if sprint == nil || sprint.ProjectID != bsonProjectID {
+			return nil, errutils.NewError(exceptions.ErrSprintNotFound, errutils.NotFound)
+		} else if sprint.Status == models.SprintStatusCompleted {
+			return nil, errutils.NewError(exceptions.ErrSprintAlreadyComplete, errutils.BadRequest)
+		}
+
+		bsonSprintID = &sprintID
+	}
+
+	var updatedCount int64
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		tasks, err := s.taskRepo.FindByProjectIDAndTaskIDs(ctx, bsonProjectID, req.TaskIDs)
+		if err != nil {
+			return err
+		}
+
+		updatedCount, err = s.taskRepo.UpdateSprint(ctx, &repositories.UpdateTasksSprintRequest{
+			ProjectID: bsonProjectID,
+			TaskIDs:   req.TaskIDs,
+			SprintID:  bsonSprintID,
+			UpdatedBy: bsonUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.activityRepo.CreateMany(ctx, newTaskSprintActivities(tasks, bsonSprintID, bsonUserID))
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	s.publishTasksUpdated(ctx, bsonProjectID, req.TaskIDs, bsonUserID)
+
+	return &responses.MoveTasksToSprintResponse{
+		Message:          "Tasks moved successfully",
+		UpdatedTaskCount: updatedCount,
+	}, nil
+}
+
+// isSprintPlanned reports whether the sprint has not been started yet.
+// Sprints created before sprint statuses existed have an empty status and are treated as planned.
+func isSprintPlanned(sprint *models.Sprint) bool {
+	return sprint.Status == models.SprintStatusPlanned || sprint.Status == ""
+}
+
+func (s *sprintServiceImpl) recordSprintActivity(ctx context.Context, sprint *models.Sprint, action models.ActivityAction, changes []models.ActivityChange, userID bson.ObjectID) *errutils.Error {
+	err := s.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  sprint.ProjectID,
+		EntityType: models.ActivityEntityTypeSprint,
+		EntityID:   sprint.ID.Hex(),
+		Action:     action,
+		Changes:    changes,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		return errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return nil
+}
+
+// publishSprintUpdated sends the sprint as it is stored now to the project board
+// and to the project webhooks subscribed to the given events
+func (s *sprintServiceImpl) publishSprintUpdated(ctx context.Context, sprintID bson.ObjectID, userID bson.ObjectID, webhookEvents ...models.WebhookEvent) {
+	sprint, err := s.sprintRepo.FindByID(ctx, sprintID)
+	if err != nil || sprint == nil {
+		log.Printf("⚠️ Failed to load sprint %s for board event: %v\n", sprintID.Hex(), err)
+		return
+	}
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeSprintUpdated, sprint.ProjectID, sprint, userID)
+
+	for _, webhookEvent := range webhookEvents {
+		s.webhookService.Dispatch(ctx, webhookEvent, sprint.ProjectID, sprint, userID)
+	}
+}
+
+// publishTasksUpdated sends the given tasks as they are stored now to the project board and webhooks
+func (s *sprintServiceImpl) publishTasksUpdated(ctx context.Context, projectID bson.ObjectID, taskIDs []string, userID bson.ObjectID) {
+	if len(taskIDs) == 0 {
+		return
+	}
+
+	tasks, err := s.taskRepo.FindByProjectIDAndTaskIDs(ctx, projectID, taskIDs)
+	if err != nil {
+		log.Printf("⚠️ Failed to load tasks for board event: %v\n", err)
+		return
+	}
+
+	for _, task := range tasks {
+		s.boardEventService.Publish(ctx, models.BoardEventTypeTaskUpdated, projectID, task, userID)
+		s.webhookService.Dispatch(ctx, models.WebhookEventTaskUpdated, projectID, task, userID)
+	}
+}
+
+// notifyProjectMembers sends a sprint notification to every active member of the sprint's project
+func (s *sprintServiceImpl) notifyProjectMembers(ctx context.Context, sprint *models.Sprint, notificationType models.NotificationType, message string, userID bson.ObjectID) {
+	members, err := s.projectMemberRepo.FindByProjectID(ctx, sprint.ProjectID)
+	if err != nil {
+		log.Printf("⚠️ Failed to load members of project %s for sprint notifications: %v\n", sprint.ProjectID.Hex(), err)
+		return
+	}
+
+	notifications := make([]*repositories.CreateNotificationRequest, 0, len(members))
+	for _, member := range members {
+		if member.RemovedAt != nil {
+			continue
+		}
+
+		notifications = append(notifications, &repositories.CreateNotificationRequest{
+			UserID:    member.UserID,
+			Type:      notificationType,
+			Message:   message,
+			ProjectID: &sprint.ProjectID,
+			SprintID:  &sprint.ID,
+			ActorID:   userID,
+		})
+	}
+
+	s.notificationService.Notify(ctx, notifications)
+}
+
+// newTaskSprintActivities builds one activity for every task whose current sprint differs from toSprintID
+func newTaskSprintActivities(tasks []*models.Task, toSprintID *bson.ObjectID, userID bson.ObjectID) []*repositories.CreateActivityRequest {
+	activities := make([]*repositories.CreateActivityRequest, 0, len(tasks))
+	for _, task := range tasks {
+		changes := activityChanges{}
+		changes.add("sprint", taskCurrentSprintID(task), toSprintID)
+		if len(changes) == 0 {
+			continue
+		}
+
+		activities = append(activities, &repositories.CreateActivityRequest{
+			ProjectID:  task.ProjectID,
+			TaskID:     &task.TaskID,
+			EntityType: models.ActivityEntityTypeTask,
+			EntityID:   task.ID.Hex(),
+			Action:     models.ActivityActionUpdated,
+			Changes:    changes,
+			CreatedBy:  userID,
+		})
+	}
+
+	return activities
+}
@@ -2,24 +2,34 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
 	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 type TaskCommentService interface {
 	Create(ctx context.Context, req *requests.CreateTaskCommentRequest, userID string) (*models.TaskComment, *errutils.Error)
+	Update(ctx context.Context, req *requests.UpdateTaskCommentRequest, userID string) (*models.TaskComment, *errutils.Error)
+	Delete(ctx context.Context, req *requests.DeleteTaskCommentRequest, userID string) (*responses.DeleteTaskCommentResponse, *errutils.Error)
 }
 
 type taskCommentServiceImpl struct {
-	taskCommentRepo   repositories.TaskCommentRepository
-	taskRepo          repositories.TaskRepository
-	projectRepo       repositories.ProjectRepository
-	projectMemberRepo repositories.ProjectMemberRepository
+	taskCommentRepo     repositories.TaskCommentRepository
+	taskRepo            repositories.TaskRepository
+	projectRepo         repositories.ProjectRepository
+	projectMemberRepo   repositories.ProjectMemberRepository
+	userRepo            repositories.UserRepository
+	activityRepo        repositories.ActivityRepository
+	notificationService NotificationService
+	boardEventService   BoardEventService
+	webhookService      WebhookService
+	unitOfWork          repositories.UnitOfWork
 }
 
 func NewTaskCommentService(
@@ -27,12 +37,24 @@ func NewTaskCommentService(
 	taskRepo repositories.TaskRepository,
 	projectRepo repositories.ProjectRepository,
 	projectMemberRepo repositories.ProjectMemberRepository,
+	userRepo repositories.UserRepository,
+	activityRepo repositories.ActivityRepository,
+	notificationService NotificationService,
+	boardEventService BoardEventService,
+	webhookService WebhookService,
+	unitOfWork repositories.UnitOfWork,
 ) TaskCommentService {
 	return &taskCommentServiceImpl{
-		taskCommentRepo:   taskCommentRepo,
-		taskRepo:          taskRepo,
-		projectRepo:       projectRepo,
-		projectMemberRepo: projectMemberRepo,
+		taskCommentRepo:     taskCommentRepo,
+		taskRepo:            taskRepo,
+		projectRepo:         projectRepo,
+		projectMemberRepo:   projectMemberRepo,
+		userRepo:            userRepo,
+		activityRepo:        activityRepo,
+		notificationService: notificationService,
+		boardEventService:   boardEventService,
+		webhookService:      webhookService,
+		unitOfWork:          unitOfWork,
 	}
 }
 
@@ -52,18 +74,201 @@ func (s *taskCommentServiceImpl) Create(ctx context.Context, req *requests.Creat
 	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
 	}
 
+	var parentID *bson.ObjectID
+	if req.ParentID != nil {
+		bsonParentID, err := bson.ObjectIDFromHex(*req.ParentID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInvalidParentComment, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+
+		parent, err := s.taskCommentRepo.FindByID(ctx, bsonParentID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		} else if parent == nil || parent.TaskID != task.TaskID {
+			return nil, errutils.NewError(exceptions.ErrTaskCommentNotFound, errutils.BadRequest).WithDebugMessage("parent comment not found")
+		}
+
+		// Only one level of replies is supported
+		if parent.ParentID != nil {
+			return nil, errutils.NewError(exceptions.ErrInvalidParentComment, errutils.BadRequest).WithDebugMessage("parent comment is already a reply")
+		} else if parent.IsDeleted() {
+			return nil, errutils.NewError(exceptions.ErrTaskCommentDeleted, errutils.BadRequest).WithDebugMessage("parent comment is deleted")
+		}
+
+		parentID = &parent.ID
+	}
+
+	mentions, err := resolveMentions(ctx, s.projectMemberRepo, s.userRepo, task.ProjectID, &req.Content)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
 	comment, err := s.taskCommentRepo.Create(ctx, &repositories.CreateTaskCommentRequest{
-		TaskID:  req.TaskID,
-		Content: req.Content,
-		UserID:  bsonUserID,
+		TaskID:   req.TaskID,
+		Content:  req.Content,
+		UserID:   bsonUserID,
+		ParentID: parentID,
+		Mentions: mentions,
 	})
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
 	}
 
+	err = s.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  task.ProjectID,
+		TaskID:     &task.TaskID,
+		EntityType: models.ActivityEntityTypeTaskComment,
+		EntityID:   comment.ID.Hex(),
+		Action:     models.ActivityActionCreated,
+		Changes: []models.ActivityChange{
+			{Field: "content", NewValue: comment.Content},
+		},
+		CreatedBy: bsonUserID,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	notifyMentionedUsers(ctx, s.notificationService, comment.Mentions, nil, task.ProjectID, task.TaskID, bsonUserID, fmt.Sprintf("You were mentioned in a comment on %s", task.TaskID))
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeCommentCreated, task.ProjectID, comment, bsonUserID)
+	s.webhookService.Dispatch(ctx, models.WebhookEventCommentCreated, task.ProjectID, comment, bsonUserID)
+
 	return comment, nil
 }
+
+func (s *taskCommentServiceImpl) Update(ctx context.Context, req *requests.UpdateTaskCommentRequest, userID string) (*models.TaskComment, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	task, comment, errWithStatus := s.findEditableComment(ctx, req.TaskID, req.CommentID, bsonUserID)
+	if errWithStatus != nil {
+		return nil, errWithStatus
+	}
+
+	mentions, err := resolveMentions(ctx, s.projectMemberRepo, s.userRepo, task.ProjectID, &req.Content)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	updatedComment, err := s.taskCommentRepo.UpdateContent(ctx, &repositories.UpdateTaskCommentContentRequest{
+		ID:       comment.ID,
+		Content:  req.Content,
+		Mentions: mentions,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if updatedComment == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskCommentDeleted, errutils.BadRequest).WithDebugMessage("task comment is deleted")
+	}
+
+	err = s.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  task.ProjectID,
+		TaskID:     &task.TaskID,
+		EntityType: models.ActivityEntityTypeTaskComment,
+		EntityID:   comment.ID.Hex(),
+		Action:     models.ActivityActionUpdated,
+		Changes: []models.ActivityChange{
+			{Field: "content", OldValue: comment.Content, NewValue: updatedComment.Content},
+		},
+		CreatedBy: bsonUserID,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	notifyMentionedUsers(ctx, s.notificationService, updatedComment.Mentions, comment.Mentions, task.ProjectID, task.TaskID, bsonUserID, fmt.Sprintf("You were mentioned in a comment on %s", task.TaskID))
+
+	return updatedComment, nil
+}
+
+func (s *taskCommentServiceImpl) Delete(ctx context.Context, req *requests.DeleteTaskCommentRequest, userID string) (*responses.DeleteTaskCommentResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	task, comment, errWithStatus := s.findEditableComment(ctx, req.TaskID, req.CommentID, bsonUserID)
+	if errWithStatus != nil {
+		return nil, errWithStatus
+	}
+
+	// The content of a deleted comment must not stay readable through its activities
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		err := s.taskCommentRepo.SoftDelete(ctx, &repositories.SoftDeleteTaskCommentRequest{
+			ID:        comment.ID,
+			DeletedBy: bsonUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.activityRepo.RedactChanges(ctx, &repositories.RedactActivityChangesRequest{
+			EntityType: models.ActivityEntityTypeTaskComment,
+			EntityID:   comment.ID.Hex(),
+			Field:      "content",
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+			ProjectID:  task.ProjectID,
+			TaskID:     &task.TaskID,
+			EntityType: models.ActivityEntityTypeTaskComment,
+			EntityID:   comment.ID.Hex(),
+			Action:     models.ActivityActionDeleted,
+			CreatedBy:  bsonUserID,
+		})
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.DeleteTaskCommentResponse{
+		Message: "Task comment deleted successfully",
+	}, nil
+}
+
+// findEditableComment loads a comment that is not deleted and checks that the user is its author or a project moderator
+func (s *taskCommentServiceImpl) findEditableComment(ctx context.Context, taskID string, commentID string, userID bson.ObjectID) (*models.Task, *models.TaskComment, *errutils.Error) {
+	bsonCommentID, err := bson.ObjectIDFromHex(commentID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrTaskCommentNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage("task not found")
+	}
+
+	comment, err := s.taskCommentRepo.FindByID(ctx, bsonCommentID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if comment == nil || comment.TaskID != task.TaskID {
+		return nil, nil, errutils.NewError(exceptions.ErrTaskCommentNotFound, errutils.NotFound).WithDebugMessage("task comment not found")
+	} else if comment.IsDeleted() {
+		return nil, nil, errutils.NewError(exceptions.ErrTaskCommentDeleted, errutils.BadRequest).WithDebugMessage("task comment is deleted")
+	}
+
+	if comment.UserID == userID {
+		return task, comment, nil
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, userID)
+	if err != nil {
+		return nil, nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil || !member.Role.Can(models.PermissionTaskCommentModerate) {
+		return nil, nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden).WithDebugMessage("Only the author or a project moderator can modify this comment")
+	}
+
+	return task, comment, nil
+}
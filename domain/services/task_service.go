@@ -2,10 +2,16 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cnc-csku/task-nexus-go-lib/utils/array"
 	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
 	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
@@ -16,16 +22,30 @@ import (
 
 type TaskService interface {
 	Create(ctx context.Context, req *requests.CreateTaskRequest, userID string) (*models.Task, *errutils.Error)
+	CreateMany(ctx context.Context, reqs []*requests.CreateTaskRequest, userID string) ([]*models.Task, *errutils.Error)
 	GetTaskDetail(ctx context.Context, req *requests.GetTaskDetailPathParam, userId string) (*responses.GetTaskDetailResponse, *errutils.Error)
+	ListTasks(ctx context.Context, req *requests.ListTasksRequest, userID string) (*responses.ListTasksResponse, *errutils.Error)
+	UpdateDetail(ctx context.Context, req *requests.UpdateTaskDetailRequest, userID string) (*models.Task, *errutils.Error)
+	UpdateStatus(ctx context.Context, req *requests.UpdateTaskStatusRequest, userID string) (*models.Task, *errutils.Error)
+	AddAssignees(ctx context.Context, req *requests.AddTaskAssigneesRequest, userID string) (*models.Task, *errutils.Error)
+	ReplaceAssignees(ctx context.Context, req *requests.ReplaceTaskAssigneesRequest, userID string) (*models.Task, *errutils.Error)
+	RemoveAssignee(ctx context.Context, req *requests.RemoveTaskAssigneeRequest, userID string) (*models.Task, *errutils.Error)
+	Approve(ctx context.Context, req *requests.ApproveTaskRequest, userID string) (*models.Task, *errutils.Error)
 }
 
 type taskServiceImpl struct {
-	taskRepo          repositories.TaskRepository
-	projectRepo       repositories.ProjectRepository
-	projectMemberRepo repositories.ProjectMemberRepository
-	sprintRepo        repositories.SprintRepository
-	taskCommentRepo   repositories.TaskCommentRepository
-	userRepo          repositories.UserRepository
+	taskRepo              repositories.TaskRepository
+	projectRepo           repositories.ProjectRepository
+	projectMemberRepo     repositories.ProjectMemberRepository
+	sprintRepo            repositories.SprintRepository
+	taskCommentRepo       repositories.TaskCommentRepository
+	userRepo              repositories.UserRepository
+	activityRepo          repositories.ActivityRepository
+	notificationService   NotificationService
+	boardEventService     BoardEventService
+	webhookService        WebhookService
+	taskSimilarityService TaskSimilarityService
+	unitOfWork            repositories.UnitOfWork
 }
 
 func NewTaskService(
@@ -35,14 +55,26 @@ func NewTaskService(
 	sprintRepo repositories.SprintRepository,
 	taskCommentRepo repositories.TaskCommentRepository,
 	userRepo repositories.UserRepository,
+	activityRepo repositories.ActivityRepository,
+	notificationService NotificationService,
+	boardEventService BoardEventService,
+	webhookService WebhookService,
+	taskSimilarityService TaskSimilarityService,
+	unitOfWork repositories.UnitOfWork,
 ) TaskService {
 	return &taskServiceImpl{
-		taskRepo:          taskRepo,
-		projectRepo:       projectRepo,
-		projectMemberRepo: projectMemberRepo,
-		sprintRepo:        sprintRepo,
-		taskCommentRepo:   taskCommentRepo,
-		userRepo:          userRepo,
+		taskRepo:              taskRepo,
+		projectRepo:           projectRepo,
+		projectMemberRepo:     projectMemberRepo,
+		sprintRepo:            sprintRepo,
+		taskCommentRepo:       taskCommentRepo,
+		userRepo:              userRepo,
+		activityRepo:          activityRepo,
+		notificationService:   notificationService,
+		boardEventService:     boardEventService,
+		webhookService:        webhookService,
+		taskSimilarityService: taskSimilarityService,
+		unitOfWork:            unitOfWork,
 	}
 }
 
@@ -52,6 +84,73 @@ func (s *taskServiceImpl) Create(ctx context.Context, req *requests.CreateTaskRe
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
 	}
 
+	draft, serviceErr := s.prepareTask(ctx, req, bsonUserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	var task *models.Task
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		task, err = s.insertTask(ctx, draft)
+		return err
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	s.publishCreatedTask(ctx, task)
+
+	return task, nil
+}
+
+// CreateMany creates every task or none of them, all requests are validated before the first task is inserted
+func (s *taskServiceImpl) CreateMany(ctx context.Context, reqs []*requests.CreateTaskRequest, userID string) ([]*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	drafts := make([]*taskDraft, 0, len(reqs))
+	for _, req := range reqs {
+		draft, serviceErr := s.prepareTask(ctx, req, bsonUserID)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		drafts = append(drafts, draft)
+	}
+
+	var tasks []*models.Task
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// The transaction can be retried, so tasks from a previous try are dropped
+		tasks = make([]*models.Task, 0, len(drafts))
+		for _, draft := range drafts {
+			task, err := s.insertTask(ctx, draft)
+			if err != nil {
+				return err
+			}
+			tasks = append(tasks, task)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	for _, task := range tasks {
+		s.publishCreatedTask(ctx, task)
+	}
+
+	return tasks, nil
+}
+
+// taskDraft is a validated task that only needs a task ID to be inserted
+type taskDraft struct {
+	projectPrefix string
+	in            *repositories.CreateTaskRequest
+}
+
+// prepareTask validates the request and resolves everything the task needs except its task ID
+func (s *taskServiceImpl) prepareTask(ctx context.Context, req *requests.CreateTaskRequest, bsonUserID bson.ObjectID) (*taskDraft, *errutils.Error) {
 	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
@@ -60,7 +159,7 @@ func (s *taskServiceImpl) Create(ctx context.Context, req *requests.CreateTaskRe
 	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
 	}
 
@@ -111,10 +210,16 @@ func (s *taskServiceImpl) Create(ctx context.Context, req *requests.CreateTaskRe
 		}
 
 		taskSprint = &models.TaskSprint{
-			CurrentSprintID: *bsonSprintID,
+			PreviousSprintIDs: []bson.ObjectID{},
+			CurrentSprintID:   bsonSprintID,
 		}
 	}
 
+	attributes, serviceErr := buildTaskAttributes(project.AttributeTemplates, req.Attributes)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	var defaultWorkflow *models.Workflow
 	for _, workflow := range project.Workflows {
 		if workflow.IsDefault {
@@ -126,29 +231,78 @@ func (s *taskServiceImpl) Create(ctx context.Context, req *requests.CreateTaskRe
 		return nil, errutils.NewError(exceptions.ErrDefaultWorkflowNotFound, errutils.InternalServerError)
 	}
 
-	task, err := s.taskRepo.Create(ctx, &repositories.CreateTaskRequest{
-		TaskID:      fmt.Sprintf("%s-%d", project.ProjectPrefix, project.TaskRunningNumber),
-		ProjectID:   bsonProjectID,
-		Title:       req.Title,
-		Description: req.Description,
-		ParentID:    parentID,
-		Type:        models.TaskType(req.Type),
-		Status:      defaultWorkflow.Status,
-		Sprint:      taskSprint,
-		CreatedBy:   bsonUserID,
-	})
+	mentions, err := resolveMentions(ctx, s.projectMemberRepo, s.userRepo, bsonProjectID, req.Description)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
 	}
 
-	err = s.projectRepo.IncrementTaskRunningNumber(ctx, bsonProjectID)
+	if req.CheckDuplicates {
+		duplicates := s.taskSimilarityService.FindDuplicates(ctx, bsonProjectID, req.Title, req.Description)
+		if len(duplicates) > 0 {
+			duplicateTaskIDs := make([]string, 0, len(duplicates))
+			for _, duplicate := range duplicates {
+				duplicateTaskIDs = append(duplicateTaskIDs, duplicate.TaskID)
+			}
+			return nil, errutils.NewError(exceptions.ErrPossibleDuplicateTask, errutils.Conflict).WithMessage(fmt.Sprintf("%s: %s", exceptions.ErrPossibleDuplicateTask, strings.Join(duplicateTaskIDs, ", ")))
+		}
+	}
+
+	return &taskDraft{
+		projectPrefix: project.ProjectPrefix,
+		in: &repositories.CreateTaskRequest{
+			ProjectID:   bsonProjectID,
+			Title:       req.Title,
+			Description: req.Description,
+			ParentID:    parentID,
+			Type:        models.TaskType(req.Type),
+			Status:      defaultWorkflow.Status,
+			Sprint:      taskSprint,
+			Attributes:  attributes,
+			Mentions:    mentions,
+			CreatedBy:   bsonUserID,
+		},
+	}, nil
+}
+
+// insertTask takes the next task ID of the project and stores the task with its activity, it must run in a unit of work
+func (s *taskServiceImpl) insertTask(ctx context.Context, draft *taskDraft) (*models.Task, error) {
+	taskRunningNumber, err := s.projectRepo.NextTaskRunningNumber(ctx, draft.in.ProjectID)
 	if err != nil {
-		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		return nil, err
+	}
+
+	in := *draft.in
+	in.TaskID = fmt.Sprintf("%s-%d", draft.projectPrefix, taskRunningNumber)
+
+	task, err := s.taskRepo.Create(ctx, &in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.activityRepo.Create(ctx, &repositories.CreateActivityRequest{
+		ProjectID:  task.ProjectID,
+		TaskID:     &task.TaskID,
+		EntityType: models.ActivityEntityTypeTask,
+		EntityID:   task.ID.Hex(),
+		Action:     models.ActivityActionCreated,
+		CreatedBy:  task.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return task, nil
 }
 
+// publishCreatedTask runs the best effort side effects of a committed task
+func (s *taskServiceImpl) publishCreatedTask(ctx context.Context, task *models.Task) {
+	notifyMentionedUsers(ctx, s.notificationService, task.Mentions, nil, task.ProjectID, task.TaskID, task.CreatedBy, fmt.Sprintf("You were mentioned in the description of %s", task.TaskID))
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeTaskCreated, task.ProjectID, task, task.CreatedBy)
+	s.webhookService.Dispatch(ctx, models.WebhookEventTaskCreated, task.ProjectID, task, task.CreatedBy)
+	s.taskSimilarityService.IndexTask(ctx, task)
+}
+
 func validateParentTaskType(taskType string, parentTaskType models.TaskType) *errutils.Error {
 	switch taskType {
 	case models.TaskTypeEpic.String():
@@ -188,7 +342,7 @@ func (s *taskServiceImpl) GetTaskDetail(ctx context.Context, req *requests.GetTa
 	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
 	if err != nil {
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
-	} else if member == nil {
+	} else if member == nil || member.RemovedAt != nil {
 		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
 	}
 
@@ -233,6 +387,8 @@ func (s *taskServiceImpl) GetTaskDetail(ctx context.Context, req *requests.GetTa
 		Approval:           task.Approval,
 		Assignee:           task.Assignee,
 		Sprint:             task.Sprint,
+		Attributes:         task.Attributes,
+		Mentions:           task.Mentions,
 		CreatedAt:          task.CreatedAt,
 		CreatedBy:          task.CreatedBy.Hex(),
 		CreatorDisplayName: creator.DisplayName,
@@ -259,18 +415,849 @@ func mapUsersByID(users []models.User) map[string]string {
 	return userMap
 }
 
+// buildTaskComments nests replies under their top-level comment.
+// Deleted comments keep their place in the thread with the content removed.
 func buildTaskComments(comments []*models.TaskComment, userMap map[string]string) []responses.GetTaskDetailResponseTaskComment {
-	taskComments := make([]responses.GetTaskDetailResponseTaskComment, 0, len(comments))
+	topLevelIDs := make(map[bson.ObjectID]bool, len(comments))
 	for _, comment := range comments {
-		taskComments = append(taskComments, responses.GetTaskDetailResponseTaskComment{
-			ID:              comment.ID.Hex(),
-			Content:         comment.Content,
-			UserID:          comment.UserID.Hex(),
-			UserDisplayName: userMap[comment.UserID.Hex()],
-			TaskID:          comment.TaskID,
-			CreatedAt:       comment.CreatedAt,
-			UpdatedAt:       comment.UpdatedAt,
-		})
+		if comment.ParentID == nil {
+			topLevelIDs[comment.ID] = true
+		}
+	}
+
+	repliesByParentID := make(map[bson.ObjectID][]responses.GetTaskDetailResponseTaskComment)
+	for _, comment := range comments {
+		if comment.ParentID != nil && topLevelIDs[*comment.ParentID] {
+			repliesByParentID[*comment.ParentID] = append(repliesByParentID[*comment.ParentID], buildTaskComment(comment, userMap))
+		}
+	}
+
+	taskComments := make([]responses.GetTaskDetailResponseTaskComment, 0, len(topLevelIDs))
+	for _, comment := range comments {
+		// Replies whose parent is missing are shown as top-level comments
+		if comment.ParentID != nil && topLevelIDs[*comment.ParentID] {
+			continue
+		}
+
+		taskComment := buildTaskComment(comment, userMap)
+		taskComment.Replies = repliesByParentID[comment.ID]
+		taskComments = append(taskComments, taskComment)
 	}
 	return taskComments
 }
+
+func buildTaskComment(comment *models.TaskComment, userMap map[string]string) responses.GetTaskDetailResponseTaskComment {
+	var parentID *string
+	if comment.ParentID != nil {
+		hex := comment.ParentID.Hex()
+		parentID = &hex
+	}
+
+	content := comment.Content
+	mentions := comment.Mentions
+	if comment.IsDeleted() {
+		content = ""
+		mentions = nil
+	}
+
+	return responses.GetTaskDetailResponseTaskComment{
+		ID:              comment.ID.Hex(),
+		Content:         content,
+		UserID:          comment.UserID.Hex(),
+		UserDisplayName: userMap[comment.UserID.Hex()],
+		TaskID:          comment.TaskID,
+		ParentID:        parentID,
+		Mentions:        mentions,
+		IsEdited:        comment.IsEdited(),
+		IsDeleted:       comment.IsDeleted(),
+		CreatedAt:       comment.CreatedAt,
+		UpdatedAt:       comment.UpdatedAt,
+	}
+}
+
+func (s *taskServiceImpl) UpdateDetail(ctx context.Context, req *requests.UpdateTaskDetailRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	// Fields that are not provided keep their current value
+	title := task.Title
+	if req.Title != nil {
+		title = *req.Title
+	}
+
+	description := task.Description
+	mentions := task.Mentions
+	if req.Description != nil {
+		description = req.Description
+
+		mentions, err = resolveMentions(ctx, s.projectMemberRepo, s.userRepo, task.ProjectID, description)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+	}
+
+	priority := task.Priority
+	if req.Priority != nil {
+		if !models.TaskPriority(*req.Priority).IsValid() {
+			return nil, errutils.NewError(exceptions.ErrInvalidTaskPriority, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid task priority: %s", *req.Priority))
+		}
+		newPriority := models.TaskPriority(*req.Priority)
+		priority = &newPriority
+	}
+
+	attributes := task.Attributes
+	if req.Attributes != nil {
+		templates, err := s.projectRepo.FindAttributeTemplatesByProjectID(ctx, task.ProjectID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+
+		var serviceErr *errutils.Error
+		attributes, serviceErr = buildTaskAttributes(templates, req.Attributes)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
+	updatedTask, err := s.taskRepo.UpdateDetail(ctx, &repositories.UpdateTaskDetailRequest{
+		ID:          task.ID,
+		Title:       title,
+		Description: description,
+		Priority:    priority,
+		Attributes:  attributes,
+		Mentions:    mentions,
+		UpdatedBy:   bsonUserID,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if updatedTask == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	if serviceErr := recordTaskActivity(ctx, s.activityRepo, task, updatedTask, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	notifyMentionedUsers(ctx, s.notificationService, updatedTask.Mentions, task.Mentions, task.ProjectID, task.TaskID, bsonUserID, fmt.Sprintf("You were mentioned in the description of %s", task.TaskID))
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeTaskUpdated, updatedTask.ProjectID, updatedTask, bsonUserID)
+	s.webhookService.Dispatch(ctx, models.WebhookEventTaskUpdated, updatedTask.ProjectID, updatedTask, bsonUserID)
+	s.taskSimilarityService.IndexTask(ctx, updatedTask)
+
+	return updatedTask, nil
+}
+
+func (s *taskServiceImpl) UpdateStatus(ctx context.Context, req *requests.UpdateTaskStatusRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	if task.Status == req.Status {
+		return task, nil
+	}
+
+	workflows, err := s.projectRepo.FindWorkflowByProjectID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	if serviceErr := validateStatusTransition(workflows, task.Status, req.Status); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if serviceErr := validateWorkflowRules(workflows, task, member, req.Status); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedTask, err := s.taskRepo.UpdateStatus(ctx, &repositories.UpdateTaskStatusRequest{
+		ID:         task.ID,
+		FromStatus: task.Status,
+		Status:     req.Status,
+		UpdatedBy:  bsonUserID,
+	})
+	if errors.Is(err, repositories.ErrWriteConflict) {
+		return nil, errutils.NewError(exceptions.ErrTaskStatusConflict, errutils.Conflict).WithDebugMessage(fmt.Sprintf("Task %s is no longer in status %s", req.TaskID, task.Status))
+	} else if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	if serviceErr := recordTaskActivity(ctx, s.activityRepo, task, updatedTask, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	// Everyone working on the task and its creator are told about the new status
+	recipientIDs := []bson.ObjectID{updatedTask.CreatedBy}
+	for _, assignee := range updatedTask.Assignee {
+		recipientIDs = append(recipientIDs, assignee.Value)
+	}
+
+	notified := make(map[bson.ObjectID]bool, len(recipientIDs))
+	notifications := make([]*repositories.CreateNotificationRequest, 0, len(recipientIDs))
+	for _, recipientID := range recipientIDs {
+		if notified[recipientID] {
+			continue
+		}
+		notified[recipientID] = true
+
+		notifications = append(notifications, &repositories.CreateNotificationRequest{
+			UserID:    recipientID,
+			Type:      models.NotificationTypeTaskStatusChanged,
+			Message:   fmt.Sprintf("%s moved from %s to %s", updatedTask.TaskID, task.Status, updatedTask.Status),
+			ProjectID: &updatedTask.ProjectID,
+			TaskID:    &updatedTask.TaskID,
+			ActorID:   bsonUserID,
+		})
+	}
+
+	s.notificationService.Notify(ctx, notifications)
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeTaskUpdated, updatedTask.ProjectID, updatedTask, bsonUserID)
+	s.webhookService.Dispatch(ctx, models.WebhookEventTaskStatusChanged, updatedTask.ProjectID, updatedTask, bsonUserID)
+
+	return updatedTask, nil
+}
+
+// validateStatusTransition checks that the target status exists in the project's
+// workflows and that it lists the current status as one of its previous statuses.
+func validateStatusTransition(workflows []models.Workflow, currentStatus string, targetStatus string) *errutils.Error {
+	for _, workflow := range workflows {
+		if workflow.Status != targetStatus {
+			continue
+		}
+
+		if !array.ContainAny(workflow.PreviousStatuses, []string{currentStatus}) {
+			return errutils.NewError(exceptions.ErrInvalidStatusChange, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Cannot change status from %s to %s", currentStatus, targetStatus))
+		}
+
+		return nil
+	}
+
+	return errutils.NewError(exceptions.ErrInvalidTaskStatus, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Status not found in project workflows: %s", targetStatus))
+}
+
+// validateWorkflowRules checks the rules of the target status against the task and the member moving it
+func validateWorkflowRules(workflows []models.Workflow, task *models.Task, member *models.ProjectMember, targetStatus string) *errutils.Error {
+	var rules *models.WorkflowRules
+	for _, workflow := range workflows {
+		if workflow.Status == targetStatus {
+			rules = workflow.Rules
+			break
+		}
+	}
+	if rules == nil {
+		return nil
+	}
+
+	if len(rules.AllowedRoles) > 0 {
+		isAllowed := false
+		for _, role := range rules.AllowedRoles {
+			if role == member.Role {
+				isAllowed = true
+				break
+			}
+		}
+		if !isAllowed {
+			return errutils.NewError(exceptions.ErrStatusRoleNotAllowed, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Role %s cannot move tasks to %s", member.Role, targetStatus))
+		}
+	}
+
+	if len(rules.AllowedPositions) > 0 && !array.ContainAny(rules.AllowedPositions, []string{member.Position}) {
+		return errutils.NewError(exceptions.ErrStatusPositionDenied, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Position %s cannot move tasks to %s", member.Position, targetStatus))
+	}
+
+	if rules.AssigneeOnly && !isTaskAssignee(task.Assignee, member.UserID) {
+		return errutils.NewError(exceptions.ErrStatusAssigneeOnly, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Only assignees can move tasks to %s", targetStatus))
+	}
+
+	missingAttributes := make([]string, 0)
+	for _, attribute := range rules.RequiredAttributes {
+		if !hasTaskAttribute(task.Attributes, attribute) {
+			missingAttributes = append(missingAttributes, attribute)
+		}
+	}
+	if len(missingAttributes) > 0 {
+		return errutils.NewError(exceptions.ErrRequiredAttributes, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Missing attributes: %s", strings.Join(missingAttributes, ", ")))
+	}
+
+	// Approvals of users who were assigned to the task after approving it do not count
+	approvals := 0
+	for _, approval := range task.Approval {
+		if canApproveTask(task, approval.UserID) {
+			approvals++
+		}
+	}
+	if approvals < rules.RequiredApprovals {
+		return errutils.NewError(exceptions.ErrNotEnoughApprovals, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("%d of %d approvals", approvals, rules.RequiredApprovals))
+	}
+
+	return nil
+}
+
+// canApproveTask reports whether userID is neither an assignee nor the creator of the task
+func canApproveTask(task *models.Task, userID bson.ObjectID) bool {
+	return task.CreatedBy != userID && !isTaskAssignee(task.Assignee, userID)
+}
+
+func isTaskAssignee(assignees []models.TaskAssignee, userID bson.ObjectID) bool {
+	for _, assignee := range assignees {
+		if assignee.Value == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTaskAttribute(attributes []models.KeyValuePair, key string) bool {
+	for _, attribute := range attributes {
+		if attribute.Key != key {
+			continue
+		}
+
+		if value, ok := attribute.Value.(string); ok {
+			return strings.TrimSpace(value) != ""
+		}
+		return attribute.Value != nil
+	}
+	return false
+}
+
+func validateListTasksPaginationRequestSortBy(sortBy string) bool {
+	switch sortBy {
+	case constant.TaskFieldTaskID, constant.TaskFieldTitle, constant.TaskFieldStatus, constant.TaskFieldPriority, constant.TaskFieldCreatedAt, constant.TaskFieldUpdatedAt:
+		return true
+	}
+	return false
+}
+
+func normalizeListTasksPaginationRequest(req *requests.ListTasksRequest) {
+	if req.PaginationRequest.Page <= 0 {
+		req.PaginationRequest.Page = 1
+	}
+	if req.PaginationRequest.PageSize <= 0 {
+		req.PaginationRequest.PageSize = 100
+	}
+	if req.PaginationRequest.SortBy == "" || !validateListTasksPaginationRequestSortBy(req.PaginationRequest.SortBy) {
+		req.PaginationRequest.SortBy = constant.TaskFieldCreatedAt
+	}
+	if req.PaginationRequest.Order == "" {
+		req.PaginationRequest.Order = constant.DESC
+	}
+}
+
+func (s *taskServiceImpl) ListTasks(ctx context.Context, req *requests.ListTasksRequest, userID string) (*responses.ListTasksResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, bsonProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	if req.Type != "" && !models.TaskType(req.Type).IsValid() {
+		return nil, errutils.NewError(exceptions.ErrInvalidTaskType, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid task type: %s", req.Type))
+	}
+
+	if req.Priority != "" && !models.TaskPriority(req.Priority).IsValid() {
+		return nil, errutils.NewError(exceptions.ErrInvalidTaskPriority, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid task priority: %s", req.Priority))
+	}
+
+	var bsonAssigneeID *bson.ObjectID
+	if req.AssigneeID != "" {
+		assigneeID, err := bson.ObjectIDFromHex(req.AssigneeID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+		bsonAssigneeID = &assigneeID
+	}
+
+	var (
+		bsonSprintID *bson.ObjectID
+		isBacklog    bool
+	)
+	if req.SprintID == constant.TaskSprintBacklog {
+		isBacklog = true
+	} else if req.SprintID != "" {
+		sprintID, err := bson.ObjectIDFromHex(req.SprintID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+		bsonSprintID = &sprintID
+	}
+
+	var attributeValue interface{}
+	if req.AttributeKey != "" {
+		templates, err := s.projectRepo.FindAttributeTemplatesByProjectID(ctx, bsonProjectID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+
+		attributes, serviceErr := buildTaskAttributes(templates, []requests.TaskAttributeRequest{
+			{Key: req.AttributeKey, Value: req.AttributeValue},
+		})
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		attributeValue = attributes[0].Value
+	}
+
+	normalizeListTasksPaginationRequest(req)
+
+	tasks, totalTask, err := s.taskRepo.Search(ctx, &repositories.SearchTaskRequest{
+		ProjectID:      bsonProjectID,
+		Status:         req.Status,
+		Type:           models.TaskType(req.Type),
+		Priority:       models.TaskPriority(req.Priority),
+		AssigneeID:     bsonAssigneeID,
+		SprintID:       bsonSprintID,
+		IsBacklog:      isBacklog,
+		ParentID:       req.ParentID,
+		Keyword:        req.Keyword,
+		AttributeKey:   req.AttributeKey,
+		AttributeValue: attributeValue,
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	taskResp := make([]responses.ListTasksResponseTask, 0, len(tasks))
+	for _, task := range tasks {
+		taskResp = append(taskResp, responses.ListTasksResponseTask{
+			ID:         task.ID.Hex(),
+			TaskID:     task.TaskID,
+			ProjectID:  task.ProjectID.Hex(),
+			Title:      task.Title,
+			ParentID:   task.ParentID,
+			Type:       task.Type,
+			Status:     task.Status,
+			Priority:   task.Priority,
+			Assignee:   task.Assignee,
+			Sprint:     task.Sprint,
+			Attributes: task.Attributes,
+			CreatedAt:  task.CreatedAt,
+			CreatedBy:  task.CreatedBy.Hex(),
+			UpdatedAt:  task.UpdatedAt,
+			UpdatedBy:  task.UpdatedBy.Hex(),
+		})
+	}
+
+	return &responses.ListTasksResponse{
+		Tasks: taskResp,
+		PaginationResponse: responses.PaginationResponse{
+			Page:      req.PaginationRequest.Page,
+			PageSize:  req.PaginationRequest.PageSize,
+			TotalPage: int(math.Ceil(float64(totalTask) / float64(req.PaginationRequest.PageSize))),
+			TotalItem: int(totalTask),
+		},
+	}, nil
+}
+
+func (s *taskServiceImpl) AddAssignees(ctx context.Context, req *requests.AddTaskAssigneesRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	newAssignees, serviceErr := s.buildTaskAssignees(ctx, task.ProjectID, req.Assignees)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	assignees := append([]models.TaskAssignee{}, task.Assignee...)
+	for _, newAssignee := range newAssignees {
+		if findTaskAssigneeIndex(assignees, newAssignee.Role, newAssignee.Value) != -1 {
+			return nil, errutils.NewError(exceptions.ErrAssigneeAlreadyExists, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("User %s is already assigned as %s", newAssignee.Value.Hex(), newAssignee.Role))
+		}
+		assignees = append(assignees, newAssignee)
+	}
+
+	return s.updateAssignees(ctx, task, assignees, bsonUserID)
+}
+
+func (s *taskServiceImpl) ReplaceAssignees(ctx context.Context, req *requests.ReplaceTaskAssigneesRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	newAssignees, serviceErr := s.buildTaskAssignees(ctx, task.ProjectID, req.Assignees)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	assignees := make([]models.TaskAssignee, 0, len(newAssignees))
+	for _, newAssignee := range newAssignees {
+		if findTaskAssigneeIndex(assignees, newAssignee.Role, newAssignee.Value) != -1 {
+			return nil, errutils.NewError(exceptions.ErrAssigneeAlreadyExists, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("User %s is assigned as %s more than once", newAssignee.Value.Hex(), newAssignee.Role))
+		}
+		assignees = append(assignees, newAssignee)
+	}
+
+	return s.updateAssignees(ctx, task, assignees, bsonUserID)
+}
+
+func (s *taskServiceImpl) RemoveAssignee(ctx context.Context, req *requests.RemoveTaskAssigneeRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonAssigneeID, err := bson.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	index := findTaskAssigneeIndex(task.Assignee, req.Role, bsonAssigneeID)
+	if index == -1 {
+		return nil, errutils.NewError(exceptions.ErrAssigneeNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("User %s is not assigned as %s", req.UserID, req.Role))
+	}
+
+	assignees := make([]models.TaskAssignee, 0, len(task.Assignee)-1)
+	assignees = append(assignees, task.Assignee[:index]...)
+	assignees = append(assignees, task.Assignee[index+1:]...)
+
+	return s.updateAssignees(ctx, task, assignees, bsonUserID)
+}
+
+func (s *taskServiceImpl) Approve(ctx context.Context, req *requests.ApproveTaskRequest, userID string) (*models.Task, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	task, err := s.taskRepo.FindByTaskID(ctx, req.TaskID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if task == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	member, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, task.ProjectID, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if member == nil || member.RemovedAt != nil {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.BadRequest).WithDebugMessage("User is not a member of the project")
+	}
+
+	if !canApproveTask(task, bsonUserID) {
+		return nil, errutils.NewError(exceptions.ErrSelfApproval, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("User %s works on task %s", userID, req.TaskID))
+	}
+
+	// A user approves a task once, approving again replaces the previous reason
+	approvals := make([]models.TaskApproval, 0, len(task.Approval)+1)
+	for _, approval := range task.Approval {
+		if approval.UserID != bsonUserID {
+			approvals = append(approvals, approval)
+		}
+	}
+	approvals = append(approvals, models.TaskApproval{
+		Reason:    req.Reason,
+		UserID:    bsonUserID,
+		CreatedAt: time.Now(),
+	})
+
+	updatedTask, err := s.taskRepo.UpdateApprovals(ctx, &repositories.UpdateTaskApprovalsRequest{
+		ID:        task.ID,
+		Approvals: approvals,
+		UpdatedBy: bsonUserID,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if updatedTask == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", req.TaskID))
+	}
+
+	if serviceErr := recordTaskActivity(ctx, s.activityRepo, task, updatedTask, bsonUserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	// The assignees are waiting for the approval to move the task on
+	notifications := make([]*repositories.CreateNotificationRequest, 0, len(updatedTask.Assignee))
+	notified := make(map[bson.ObjectID]bool, len(updatedTask.Assignee))
+	for _, assignee := range updatedTask.Assignee {
+		if notified[assignee.Value] {
+			continue
+		}
+		notified[assignee.Value] = true
+
+		notifications = append(notifications, &repositories.CreateNotificationRequest{
+			UserID:    assignee.Value,
+			Type:      models.NotificationTypeTaskApproved,
+			Message:   fmt.Sprintf("%s was approved: %s", updatedTask.TaskID, req.Reason),
+			ProjectID: &updatedTask.ProjectID,
+			TaskID:    &updatedTask.TaskID,
+			ActorID:   bsonUserID,
+		})
+	}
+
+	s.notificationService.Notify(ctx, notifications)
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeTaskUpdated, updatedTask.ProjectID, updatedTask, bsonUserID)
+	s.webhookService.Dispatch(ctx, models.WebhookEventTaskUpdated, updatedTask.ProjectID, updatedTask, bsonUserID)
+
+	return updatedTask, nil
+}
+
+func (s *taskServiceImpl) updateAssignees(ctx context.Context, task *models.Task, assignees []models.TaskAssignee, updatedBy bson.ObjectID) (*models.Task, *errutils.Error) {
+	updatedTask, err := s.taskRepo.UpdateAssignees(ctx, &repositories.UpdateTaskAssigneesRequest{
+		ID:        task.ID,
+		Assignees: assignees,
+		UpdatedBy: updatedBy,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if updatedTask == nil {
+		return nil, errutils.NewError(exceptions.ErrTaskNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Task not found: %s", task.TaskID))
+	}
+
+	if serviceErr := recordTaskActivity(ctx, s.activityRepo, task, updatedTask, updatedBy); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	// Only users that were not assigned before are notified
+	previousAssigneeIDs := make(map[bson.ObjectID]bool, len(task.Assignee))
+	for _, assignee := range task.Assignee {
+		previousAssigneeIDs[assignee.Value] = true
+	}
+
+	notifications := make([]*repositories.CreateNotificationRequest, 0, len(updatedTask.Assignee))
+	for _, assignee := range updatedTask.Assignee {
+		if previousAssigneeIDs[assignee.Value] {
+			continue
+		}
+		previousAssigneeIDs[assignee.Value] = true
+
+		notifications = append(notifications, &repositories.CreateNotificationRequest{
+			UserID:    assignee.Value,
+			Type:      models.NotificationTypeTaskAssigned,
+			Message:   fmt.Sprintf("You were assigned to %s as %s", updatedTask.TaskID, assignee.Role),
+			ProjectID: &updatedTask.ProjectID,
+			TaskID:    &updatedTask.TaskID,
+			ActorID:   updatedBy,
+		})
+	}
+
+	s.notificationService.Notify(ctx, notifications)
+
+	s.boardEventService.Publish(ctx, models.BoardEventTypeTaskUpdated, updatedTask.ProjectID, updatedTask, updatedBy)
+	s.webhookService.Dispatch(ctx, models.WebhookEventTaskUpdated, updatedTask.ProjectID, updatedTask, updatedBy)
+
+	return updatedTask, nil
+}
+
+// buildTaskAssignees validates the requested assignees against the project's positions
+// and active members, and converts them into task assignees.
+func (s *taskServiceImpl) buildTaskAssignees(ctx context.Context, projectID bson.ObjectID, reqAssignees []requests.TaskAssigneeRequest) ([]models.TaskAssignee, *errutils.Error) {
+	positions, err := s.projectRepo.FindPositionByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	assignees := make([]models.TaskAssignee, 0, len(reqAssignees))
+	for _, reqAssignee := range reqAssignees {
+		if !array.ContainAny(positions, []string{reqAssignee.Role}) {
+			return nil, errutils.NewError(exceptions.ErrInvalidAssigneeRole, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Role is not a project position: %s", reqAssignee.Role))
+		}
+
+		if reqAssignee.Point < 0 {
+			return nil, errutils.NewError(exceptions.ErrInvalidAssigneePoint, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid point: %d", reqAssignee.Point))
+		}
+
+		bsonAssigneeID, err := bson.ObjectIDFromHex(reqAssignee.UserID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+		}
+
+		assigneeMember, err := s.projectMemberRepo.FindByProjectIDAndUserID(ctx, projectID, bsonAssigneeID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		} else if assigneeMember == nil || assigneeMember.RemovedAt != nil {
+			return nil, errutils.NewError(exceptions.ErrAssigneeNotMember, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("User is not an active member of the project: %s", reqAssignee.UserID))
+		}
+
+		assignees = append(assignees, models.TaskAssignee{
+			Role:  reqAssignee.Role,
+			Value: bsonAssigneeID,
+			Point: reqAssignee.Point,
+		})
+	}
+
+	return assignees, nil
+}
+
+func findTaskAssigneeIndex(assignees []models.TaskAssignee, role string, userID bson.ObjectID) int {
+	for i, assignee := range assignees {
+		if assignee.Role == role && assignee.Value == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+// buildTaskAttributes validates each attribute key against the project's attribute templates
+// and coerces its value to the template's type. Attributes with a nil value are dropped.
+func buildTaskAttributes(templates []models.AttributeTemplate, reqAttributes []requests.TaskAttributeRequest) ([]models.KeyValuePair, *errutils.Error) {
+	templateMap := make(map[string]models.AttributeTemplate, len(templates))
+	for _, template := range templates {
+		templateMap[template.Name] = template
+	}
+
+	attributes := make([]models.KeyValuePair, 0, len(reqAttributes))
+	seenKeys := make(map[string]struct{}, len(reqAttributes))
+	for _, reqAttribute := range reqAttributes {
+		template, ok := templateMap[reqAttribute.Key]
+		if !ok {
+			return nil, errutils.NewError(exceptions.ErrAttributeNotFound, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Attribute not found: %s", reqAttribute.Key))
+		}
+
+		if _, ok := seenKeys[reqAttribute.Key]; ok {
+			return nil, errutils.NewError(exceptions.ErrDuplicateAttribute, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Duplicate attribute: %s", reqAttribute.Key))
+		}
+		seenKeys[reqAttribute.Key] = struct{}{}
+
+		if reqAttribute.Value == nil {
+			continue
+		}
+
+		value, err := coerceAttributeValue(template.Type, reqAttribute.Value)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInvalidAttributeValue, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("Invalid value for attribute %s: %s", reqAttribute.Key, err.Error()))
+		}
+
+		attributes = append(attributes, models.KeyValuePair{
+			Key:   template.Name,
+			Type:  template.Type,
+			Value: value,
+		})
+	}
+
+	return attributes, nil
+}
+
+// coerceAttributeValue converts a value decoded from JSON or a query string into the
+// Go type stored for the given attribute type.
+func coerceAttributeValue(valueType models.KeyValuePairType, value interface{}) (interface{}, error) {
+	switch valueType {
+	case models.KeyValuePairTypeString:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case models.KeyValuePairTypeInt:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case models.KeyValuePairTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case models.KeyValuePairTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			if date, err := time.Parse(constant.TimeFormat, v); err == nil {
+				return date, nil
+			}
+			return time.Parse(constant.DateFormat, v)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported attribute type: %s", valueType)
+	}
+
+	return nil, fmt.Errorf("expected a value of type %s, got %T", valueType, value)
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type fakeTaskRepo struct {
+	repositories.TaskRepository
+	task *models.Task
+	// storedStatus is the status in the database, another request may have moved the task since it was read
+	storedStatus string
+}
+
+func (f *fakeTaskRepo) FindByTaskID(ctx context.Context, taskID string) (*models.Task, error) {
+	if f.task == nil || f.task.TaskID != taskID {
+		return nil, nil
+	}
+	return f.task, nil
+}
+
+func (f *fakeTaskRepo) UpdateStatus(ctx context.Context, in *repositories.UpdateTaskStatusRequest) (*models.Task, error) {
+	if in.FromStatus != f.storedStatus {
+		return nil, repositories.ErrWriteConflict
+	}
+	panic("status must not be updated")
+}
+
+func (f *fakeTaskRepo) UpdateApprovals(ctx context.Context, in *repositories.UpdateTaskApprovalsRequest) (*models.Task, error) {
+	panic("approvals must not be updated")
+}
+
+type fakeTaskMemberRepo struct {
+	repositories.ProjectMemberRepository
+	members map[bson.ObjectID]*models.ProjectMember
+}
+
+func (f *fakeTaskMemberRepo) FindByProjectIDAndUserID(ctx context.Context, projectID bson.ObjectID, userID bson.ObjectID) (*models.ProjectMember, error) {
+	return f.members[userID], nil
+}
+
+func TestApproveRejectsRemovedMembersAndTaskOwners(t *testing.T) {
+	creatorID, assigneeID, removedID := bson.NewObjectID(), bson.NewObjectID(), bson.NewObjectID()
+	removedAt := time.Now()
+
+	task := &models.Task{
+		ID:        bson.NewObjectID(),
+		TaskID:    "TN-1",
+		ProjectID: bson.NewObjectID(),
+		Assignee:  []models.TaskAssignee{{Role: "Developer", Value: assigneeID}},
+		CreatedBy: creatorID,
+	}
+	service := &taskServiceImpl{
+		taskRepo: &fakeTaskRepo{task: task},
+		projectMemberRepo: &fakeTaskMemberRepo{members: map[bson.ObjectID]*models.ProjectMember{
+			creatorID:  {UserID: creatorID, Role: models.ProjectMemberRoleMember},
+			assigneeID: {UserID: assigneeID, Role: models.ProjectMemberRoleMember},
+			removedID:  {UserID: removedID, Role: models.ProjectMemberRoleMember, RemovedAt: &removedAt},
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		userID     bson.ObjectID
+		wantErr    error
+		wantStatus errutils.ErrorStatus
+	}{
+		{name: "creator", userID: creatorID, wantErr: exceptions.ErrSelfApproval, wantStatus: errutils.BadRequest},
+		{name: "assignee", userID: assigneeID, wantErr: exceptions.ErrSelfApproval, wantStatus: errutils.BadRequest},
+		{name: "removed member", userID: removedID, wantErr: exceptions.ErrPermissionDenied, wantStatus: errutils.BadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Approve(context.Background(), &requests.ApproveTaskRequest{TaskID: "TN-1", Reason: "looks good"}, tt.userID.Hex())
+			assertServiceError(t, err, tt.wantErr, tt.wantStatus)
+		})
+	}
+}
+
+func TestValidateWorkflowRulesCountsIndependentApprovals(t *testing.T) {
+	creatorID, assigneeID, reviewerID := bson.NewObjectID(), bson.NewObjectID(), bson.NewObjectID()
+	workflows := []models.Workflow{{Status: "DONE", Rules: &models.WorkflowRules{RequiredApprovals: 1}}}
+	member := &models.ProjectMember{UserID: assigneeID, Role: models.ProjectMemberRoleMember}
+
+	task := &models.Task{
+		Assignee:  []models.TaskAssignee{{Role: "Developer", Value: assigneeID}},
+		CreatedBy: creatorID,
+		Approval: []models.TaskApproval{
+			{UserID: creatorID},
+			{UserID: assigneeID},
+		},
+	}
+	assertServiceError(t, validateWorkflowRules(workflows, task, member, "DONE"), exceptions.ErrNotEnoughApprovals, errutils.BadRequest)
+
+	task.Approval = append(task.Approval, models.TaskApproval{UserID: reviewerID})
+	if err := validateWorkflowRules(workflows, task, member, "DONE"); err != nil {
+		t.Fatalf("validateWorkflowRules() error = %v", err)
+	}
+}
+
+func TestUpdateStatusRejectsTasksMovedSinceTheRead(t *testing.T) {
+	userID := bson.NewObjectID()
+	project := &models.Project{
+		ID: bson.NewObjectID(),
+		Workflows: []models.Workflow{
+			{Status: "TODO", IsDefault: true},
+			{Status: "IN_PROGRESS", PreviousStatuses: []string{"TODO"}},
+			{Status: "DONE", PreviousStatuses: []string{"TODO", "IN_PROGRESS"}},
+		},
+	}
+
+	// The task was read in TODO, but another request has already moved it to DONE
+	task := &models.Task{ID: bson.NewObjectID(), TaskID: "TN-1", ProjectID: project.ID, Status: "TODO"}
+	service := &taskServiceImpl{
+		taskRepo:    &fakeTaskRepo{task: task, storedStatus: "DONE"},
+		projectRepo: &fakeWorkflowProjectRepo{project: project},
+		projectMemberRepo: &fakeTaskMemberRepo{members: map[bson.ObjectID]*models.ProjectMember{
+			userID: {UserID: userID, Role: models.ProjectMemberRoleMember},
+		}},
+	}
+
+	_, err := service.UpdateStatus(context.Background(), &requests.UpdateTaskStatusRequest{TaskID: "TN-1", Status: "IN_PROGRESS"}, userID.Hex())
+	assertServiceError(t, err, exceptions.ErrTaskStatusConflict, errutils.Conflict)
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type TaskSimilarityService interface {
+	// IndexTask queues the task for RunIndexer to store its embedding, failures are only logged
+	IndexTask(ctx context.Context, task *models.Task)
+	// RunIndexer stores the embeddings of queued tasks until ctx is cancelled
+	RunIndexer(ctx context.Context)
+	// FindDuplicates returns tasks that are likely to describe the same work, it returns nothing when embeddings are unavailable
+	FindDuplicates(ctx context.Context, projectID bson.ObjectID, title string, description *string) []responses.SimilarTaskResponse
+	ListSimilar(ctx context.Context, req *requests.ListSimilarTasksRequest) (*responses.ListSimilarTasksResponse, *errutils.Error)
+}
+
+type taskSimilarityServiceImpl struct {
+	taskRepo      repositories.TaskRepository
+	embeddingRepo repositories.EmbeddingRepository
+	indexQueue    chan *models.Task
+}
+
+func NewTaskSimilarityService(
+	taskRepo repositories.TaskRepository,
+	embeddingRepo repositories.EmbeddingRepository,
+) TaskSimilarityService {
+	return &taskSimilarityServiceImpl{
+		taskRepo:      taskRepo,
+		embeddingRepo: embeddingRepo,
+		indexQueue:    make(chan *models.Task, constant.SimilarTaskIndexQueueSize),
+	}
+}
+
+// IndexTask does not wait for the embedding, so it never holds up the request that changed the task
+func (s *taskSimilarityServiceImpl) IndexTask(ctx context.Context, task *models.Task) {
+	if !s.embeddingRepo.Configured() {
+		return
+	}
+
+	select {
+	case s.indexQueue <- task:
+	default:
+		log.Printf("⚠️ Similarity index queue is full, task %s is indexed by the next search instead\n", task.TaskID)
+	}
+}
+
+func (s *taskSimilarityServiceImpl) RunIndexer(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-s.indexQueue:
+			if err := s.indexTasks(ctx, []*models.Task{task}); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️ Failed to index task %s for similarity search: %v\n", task.TaskID, err)
+			}
+		}
+	}
+}
+
+func (s *taskSimilarityServiceImpl) FindDuplicates(ctx context.Context, projectID bson.ObjectID, title string, description *string) []responses.SimilarTaskResponse {
+	// Duplicate checks are a hint, so they are skipped instead of failing when there is no provider
+	if !s.embeddingRepo.Configured() {
+		return nil
+	}
+
+	similarTasks, err := s.findSimilar(ctx, projectID, taskEmbeddingText(title, description), "", constant.SimilarTaskDuplicateThreshold, constant.SimilarTaskDefaultLimit)
+	if err != nil {
+		log.Printf("⚠️ Failed to check duplicate tasks in project %s: %v\n", projectID.Hex(), err)
+		return nil
+	}
+
+	return similarTasks
+}
+
+func (s *taskSimilarityServiceImpl) ListSimilar(ctx context.Context, req *requests.ListSimilarTasksRequest) (*responses.ListSimilarTasksResponse, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = constant.SimilarTaskDefaultLimit
+	} else if limit > constant.SimilarTaskMaxLimit {
+		limit = constant.SimilarTaskMaxLimit
+	}
+
+	similarTasks, err := s.findSimilar(ctx, bsonProjectID, req.Text, req.ExcludeTaskID, req.MinScore, limit)
+	if err != nil {
+		return nil, newAIError(err)
+	}
+
+	return &responses.ListSimilarTasksResponse{
+		Tasks: similarTasks,
+	}, nil
+}
+
+func (s *taskSimilarityServiceImpl) findSimilar(ctx context.Context, projectID bson.ObjectID, text string, excludeTaskID string, minScore float64, limit int) ([]responses.SimilarTaskResponse, error) {
+	// Tasks created before embeddings existed, or whose indexing failed, are caught up here
+	unindexedTasks, err := s.taskRepo.FindWithoutEmbedding(ctx, projectID, s.embeddingRepo.Model(), constant.SimilarTaskBackfillSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(unindexedTasks) > 0 {
+		if err := s.indexTasks(ctx, unindexedTasks); err != nil {
+			return nil, err
+		}
+	}
+
+	vectors, err := s.embeddingRepo.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	query := vectors[0]
+
+	taskEmbeddings, err := s.taskRepo.FindEmbeddingsByProjectID(ctx, projectID, s.embeddingRepo.Model())
+	if err != nil {
+		return nil, err
+	}
+
+	similarTasks := []responses.SimilarTaskResponse{}
+	for _, taskEmbedding := range taskEmbeddings {
+		if taskEmbedding.TaskID == excludeTaskID {
+			continue
+		}
+
+		score := cosineSimilarity(query, taskEmbedding.Embedding)
+		if score < minScore {
+			continue
+		}
+
+		similarTasks = append(similarTasks, responses.SimilarTaskResponse{
+			ID:     taskEmbedding.ID.Hex(),
+			TaskID: taskEmbedding.TaskID,
+			Title:  taskEmbedding.Title,
+			Type:   taskEmbedding.Type,
+			Status: taskEmbedding.Status,
+			Score:  score,
+		})
+	}
+
+	sort.Slice(similarTasks, func(i, j int) bool {
+		return similarTasks[i].Score > similarTasks[j].Score
+	})
+	if len(similarTasks) > limit {
+		similarTasks = similarTasks[:limit]
+	}
+
+	return similarTasks, nil
+}
+
+func (s *taskSimilarityServiceImpl) indexTasks(ctx context.Context, tasks []*models.Task) error {
+	texts := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		texts = append(texts, taskEmbeddingText(task.Title, task.Description))
+	}
+
+	vectors, err := s.embeddingRepo.Embed(ctx, texts)
+	if err != nil {
+		return err
+	}
+
+	for i, task := range tasks {
+		err := s.taskRepo.UpdateEmbedding(ctx, &repositories.UpdateTaskEmbeddingRequest{
+			ID:        task.ID,
+			Embedding: vectors[i],
+			Model:     s.embeddingRepo.Model(),
+			UpdatedAt: task.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func taskEmbeddingText(title string, description *string) string {
+	if description == nil || strings.TrimSpace(*description) == "" {
+		return title
+	}
+	return title + "\n\n" + *description
+}
+
+// cosineSimilarity returns 0 for vectors that can not be compared
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
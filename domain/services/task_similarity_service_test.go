@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// fakeEmbeddingRepo returns the vector configured for each text, so scores are known without a provider
+type fakeEmbeddingRepo struct {
+	configured bool
+	vectors    map[string][]float32
+	embedded   []string
+}
+
+func (f *fakeEmbeddingRepo) Configured() bool {
+	return f.configured
+}
+
+func (f *fakeEmbeddingRepo) Model() string {
+	return "test-model"
+}
+
+func (f *fakeEmbeddingRepo) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	f.embedded = append(f.embedded, texts...)
+
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, f.vectors[text])
+	}
+	return vectors, nil
+}
+
+// fakeIndexedTaskRepo keeps tasks and their embeddings, and like the mongo repository it only stores an embedding
+// computed from a task that has not been updated since
+type fakeIndexedTaskRepo struct {
+	repositories.TaskRepository
+	projectID  bson.ObjectID
+	tasks      []*models.Task
+	embeddings map[bson.ObjectID][]float32
+}
+
+func (f *fakeIndexedTaskRepo) FindWithoutEmbedding(ctx context.Context, projectID bson.ObjectID, model string, limit int) ([]*models.Task, error) {
+	tasks := []*models.Task{}
+	for _, task := range f.tasks {
+		if _, ok := f.embeddings[task.ID]; !ok && len(tasks) < limit {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+func (f *fakeIndexedTaskRepo) FindEmbeddingsByProjectID(ctx context.Context, projectID bson.ObjectID, model string) ([]*models.TaskEmbedding, error) {
+	embeddings := []*models.TaskEmbedding{}
+	for _, task := range f.tasks {
+		if embedding, ok := f.embeddings[task.ID]; ok {
+			embeddings = append(embeddings, &models.TaskEmbedding{ID: task.ID, TaskID: task.TaskID, Title: task.Title, Embedding: embedding, EmbeddingModel: model})
+		}
+	}
+	return embeddings, nil
+}
+
+func (f *fakeIndexedTaskRepo) UpdateEmbedding(ctx context.Context, in *repositories.UpdateTaskEmbeddingRequest) error {
+	for _, task := range f.tasks {
+		if task.ID == in.ID && !task.UpdatedAt.After(in.UpdatedAt) {
+			f.embeddings[task.ID] = in.Embedding
+		}
+	}
+	return nil
+}
+
+func newTestTask(taskID string, title string, updatedAt time.Time) *models.Task {
+	return &models.Task{ID: bson.NewObjectID(), TaskID: taskID, Title: title, UpdatedAt: updatedAt}
+}
+
+func TestListSimilarBackfillsUnindexedTasks(t *testing.T) {
+	now := time.Now()
+	indexed := newTestTask("TN-1", "login page", now)
+	unindexed := newTestTask("TN-2", "login form", now)
+	excluded := newTestTask("TN-3", "sign in", now)
+
+	taskRepo := &fakeIndexedTaskRepo{
+		projectID:  bson.NewObjectID(),
+		tasks:      []*models.Task{indexed, unindexed, excluded},
+		embeddings: map[bson.ObjectID][]float32{indexed.ID: {0.6, 0.8}, excluded.ID: {1, 0}},
+	}
+	embeddingRepo := &fakeEmbeddingRepo{configured: true, vectors: map[string][]float32{
+		"login form": {1, 0},
+		"login":      {1, 0},
+	}}
+	service := NewTaskSimilarityService(taskRepo, embeddingRepo)
+
+	res, err := service.ListSimilar(context.Background(), &requests.ListSimilarTasksRequest{
+		ProjectID:     taskRepo.projectID.Hex(),
+		Text:          "login",
+		ExcludeTaskID: "TN-3",
+	})
+	if err != nil {
+		t.Fatalf("ListSimilar() error = %v", err)
+	}
+
+	if _, ok := taskRepo.embeddings[unindexed.ID]; !ok {
+		t.Fatal("unindexed task was not backfilled before the search")
+	}
+	if len(res.Tasks) != 2 || res.Tasks[0].TaskID != "TN-2" || res.Tasks[1].TaskID != "TN-1" {
+		t.Fatalf("ListSimilar() = %+v, want TN-2 then TN-1", res.Tasks)
+	}
+}
+
+func TestIndexTasksSkipsTasksUpdatedSinceTheSnapshot(t *testing.T) {
+	snapshotAt := time.Now()
+	fresh := newTestTask("TN-1", "fresh", snapshotAt)
+	stale := newTestTask("TN-2", "stale", snapshotAt)
+
+	// TN-2 was edited after it was queued, its embedding would describe the old title
+	edited := *stale
+	edited.UpdatedAt = snapshotAt.Add(time.Second)
+
+	taskRepo := &fakeIndexedTaskRepo{
+		tasks:      []*models.Task{fresh, &edited},
+		embeddings: map[bson.ObjectID][]float32{},
+	}
+	embeddingRepo := &fakeEmbeddingRepo{configured: true, vectors: map[string][]float32{"fresh": {1, 0}, "stale": {0, 1}}}
+	service := NewTaskSimilarityService(taskRepo, embeddingRepo).(*taskSimilarityServiceImpl)
+
+	if err := service.indexTasks(context.Background(), []*models.Task{fresh, stale}); err != nil {
+		t.Fatalf("indexTasks() error = %v", err)
+	}
+
+	if _, ok := taskRepo.embeddings[fresh.ID]; !ok {
+		t.Fatal("embedding of the unchanged task was not stored")
+	}
+	if _, ok := taskRepo.embeddings[stale.ID]; ok {
+		t.Fatal("embedding of a task updated after the snapshot was stored")
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	now := time.Now()
+	duplicate := newTestTask("TN-1", "duplicate", now)
+	related := newTestTask("TN-2", "related", now)
+
+	tests := []struct {
+		name       string
+		configured bool
+		want       []string
+	}{
+		{name: "above the duplicate threshold", configured: true, want: []string{"TN-1"}},
+		{name: "not configured", configured: false, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := &fakeIndexedTaskRepo{
+				tasks: []*models.Task{duplicate, related},
+				// Cosine similarity with the new task is 0.99 for TN-1 and 0.8 for TN-2, the threshold is 0.85
+				embeddings: map[bson.ObjectID][]float32{duplicate.ID: {0.99, 0.141}, related.ID: {0.8, 0.6}},
+			}
+			embeddingRepo := &fakeEmbeddingRepo{configured: tt.configured, vectors: map[string][]float32{"new task": {1, 0}}}
+			service := NewTaskSimilarityService(taskRepo, embeddingRepo)
+
+			duplicates := service.FindDuplicates(context.Background(), taskRepo.projectID, "new task", nil)
+
+			got := []string(nil)
+			for _, task := range duplicates {
+				got = append(got, task.TaskID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("FindDuplicates() = %v, want %v", got, tt.want)
+			}
+			if !tt.configured && len(embeddingRepo.embedded) > 0 {
+				t.Fatalf("Embed() was called without a provider: %v", embeddingRepo.embedded)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
 	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
 	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -24,33 +26,40 @@ type UserService interface {
 	FindUserByEmail(ctx context.Context, email string) (*responses.UserResponse, *errutils.Error)
 	Search(ctx context.Context, req *requests.SearchUserParams, searcherUserId string) (*responses.ListUserResponse, *errutils.Error)
 	SetupFirstUser(ctx context.Context, req *requests.RegisterRequest) (*responses.UserWithTokenResponse, *errutils.Error)
+	RefreshToken(ctx context.Context, req *requests.RefreshTokenRequest) (*responses.TokenResponse, *errutils.Error)
+	Logout(ctx context.Context, claims *models.UserCustomClaims) (*responses.LogoutResponse, *errutils.Error)
 }
 
 type userServiceImpl struct {
 	config            *config.Config
 	userRepo          repositories.UserRepository
 	globalSettingRepo repositories.GlobalSettingRepository
+	tokenRepo         repositories.TokenRepository
 }
 
 func NewUserService(
 	config *config.Config,
 	userRepo repositories.UserRepository,
 	globalSettingRepo repositories.GlobalSettingRepository,
+	tokenRepo repositories.TokenRepository,
 ) UserService {
 	return &userServiceImpl{
 		config:            config,
 		userRepo:          userRepo,
 		globalSettingRepo: globalSettingRepo,
+		tokenRepo:         tokenRepo,
 	}
 }
 
-func (u *userServiceImpl) generateJWT(user *models.User, expireAt time.Time) (string, *errutils.Error) {
+func (u *userServiceImpl) generateJWT(user *models.User, familyID string, expireAt time.Time) (string, *errutils.Error) {
 	claims := models.UserCustomClaims{
 		ID:          user.ID.Hex(),
 		FullName:    user.FullName,
 		DisplayName: user.DisplayName,
 		Email:       user.Email,
+		FamilyID:    familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        bson.NewObjectID().Hex(),
 			Subject:   user.ID.Hex(),
 			ExpiresAt: jwt.NewNumericDate(expireAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -68,6 +77,51 @@ func (u *userServiceImpl) generateJWT(user *models.User, expireAt time.Time) (st
 	return tokenString, nil
 }
 
+func (u *userServiceImpl) generateRefreshToken(user *models.User, familyID string, expireAt time.Time) (string, *errutils.Error) {
+	claims := models.RefreshTokenClaims{
+		FamilyID: familyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        bson.NewObjectID().Hex(),
+			Subject:   user.ID.Hex(),
+			ExpiresAt: jwt.NewNumericDate(expireAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	tokenString, err := token.SignedString([]byte(u.config.JWT.RefreshTokenSecret))
+	if err != nil {
+		return "", errutils.NewError(exceptions.ErrInternalError, errutils.InternalError)
+	}
+
+	return tokenString, nil
+}
+
+// generateTokens issues an access token and a refresh token belonging to the same token family
+func (u *userServiceImpl) generateTokens(user *models.User, familyID string) (*responses.TokenResponse, *errutils.Error) {
+	now := time.Now()
+	tokenExpireAt := now.Add(u.config.JWT.AccessTokenExpiresIn)
+	refreshTokenExpireAt := now.Add(u.config.JWT.RefreshTokenExpiresIn)
+
+	token, tokenErr := u.generateJWT(user, familyID, tokenExpireAt)
+	if tokenErr != nil {
+		return nil, tokenErr
+	}
+
+	refreshToken, tokenErr := u.generateRefreshToken(user, familyID, refreshTokenExpireAt)
+	if tokenErr != nil {
+		return nil, tokenErr
+	}
+
+	return &responses.TokenResponse{
+		Token:                token,
+		TokenExpireAt:        tokenExpireAt,
+		RefreshToken:         refreshToken,
+		RefreshTokenExpireAt: refreshTokenExpireAt,
+	}, nil
+}
+
 func (u *userServiceImpl) Register(ctx context.Context, req *requests.RegisterRequest) (*responses.UserWithTokenResponse, *errutils.Error) {
 	// Check if email already exists
 	existsUser, err := u.userRepo.FindByEmail(ctx, req.Email)
@@ -107,10 +161,8 @@ func (u *userServiceImpl) Register(ctx context.Context, req *requests.RegisterRe
 		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalError)
 	}
 
-	// Generate JWT token
-	expireAt := time.Now().Add(time.Hour * 120)
-
-	token, tokenErr := u.generateJWT(createdUser, expireAt)
+	// Generate JWT tokens, every login starts a new token family
+	tokens, tokenErr := u.generateTokens(createdUser, bson.NewObjectID().Hex())
 	if tokenErr != nil {
 		return nil, tokenErr
 	}
@@ -125,8 +177,7 @@ func (u *userServiceImpl) Register(ctx context.Context, req *requests.RegisterRe
 			CreatedAt:   createdUser.CreatedAt,
 			UpdatedAt:   createdUser.UpdatedAt,
 		},
-		Token:         token,
-		TokenExpireAt: expireAt,
+		TokenResponse: *tokens,
 	}
 	return res, nil
 }
@@ -148,10 +199,8 @@ func (u *userServiceImpl) Login(ctx context.Context, req *requests.LoginRequest)
 		return nil, errutils.NewError(exceptions.ErrInvalidCredentials, errutils.Unauthorized)
 	}
 
-	// Generate JWT token
-	expireAt := time.Now().Add(time.Hour * 120)
-
-	token, tokenErr := u.generateJWT(user, expireAt)
+	// Generate JWT tokens, every login starts a new token family
+	tokens, tokenErr := u.generateTokens(user, bson.NewObjectID().Hex())
 	if tokenErr != nil {
 		return nil, tokenErr
 	}
@@ -166,8 +215,7 @@ func (u *userServiceImpl) Login(ctx context.Context, req *requests.LoginRequest)
 			CreatedAt:   user.CreatedAt,
 			UpdatedAt:   user.UpdatedAt,
 		},
-		Token:         token,
-		TokenExpireAt: expireAt,
+		TokenResponse: *tokens,
 	}
 	return res, nil
 }
@@ -298,3 +346,73 @@ func (u *userServiceImpl) SetupFirstUser(ctx context.Context, req *requests.Regi
 
 	return newUser, nil
 }
+
+func (u *userServiceImpl) RefreshToken(ctx context.Context, req *requests.RefreshTokenRequest) (*responses.TokenResponse, *errutils.Error) {
+	token, err := jwt.ParseWithClaims(req.RefreshToken, &models.RefreshTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(u.config.JWT.RefreshTokenSecret), nil
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized).WithDebugMessage(err.Error())
+	}
+
+	claims, ok := token.Claims.(*models.RefreshTokenClaims)
+	if !ok || !token.Valid || claims.ID == "" || claims.FamilyID == "" {
+		return nil, errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized)
+	}
+
+	isFamilyRevoked, err := u.tokenRepo.IsTokenFamilyRevoked(ctx, claims.FamilyID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if isFamilyRevoked {
+		return nil, errutils.NewError(exceptions.ErrTokenRevoked, errutils.Unauthorized)
+	}
+
+	// Each refresh token can be used only once
+	isFirstUse, err := u.tokenRepo.RevokeToken(ctx, claims.ID, time.Until(claims.ExpiresAt.Time))
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if !isFirstUse {
+		// A reused refresh token means it has leaked, so revoke every token issued from the same login
+		err = u.tokenRepo.RevokeTokenFamily(ctx, claims.FamilyID, u.config.JWT.RefreshTokenExpiresIn)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+		return nil, errutils.NewError(exceptions.ErrTokenRevoked, errutils.Unauthorized).WithDebugMessage("Refresh token reuse detected")
+	}
+
+	bsonUserID, err := bson.ObjectIDFromHex(claims.Subject)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized).WithDebugMessage(err.Error())
+	}
+
+	user, err := u.userRepo.FindByID(ctx, bsonUserID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if user == nil {
+		return nil, errutils.NewError(exceptions.ErrUserNotFound, errutils.Unauthorized)
+	}
+
+	return u.generateTokens(user, claims.FamilyID)
+}
+
+func (u *userServiceImpl) Logout(ctx context.Context, claims *models.UserCustomClaims) (*responses.LogoutResponse, *errutils.Error) {
+	if claims.ExpiresAt != nil {
+		_, err := u.tokenRepo.RevokeToken(ctx, claims.RegisteredClaims.ID, time.Until(claims.ExpiresAt.Time))
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+	}
+
+	// Revoking the family invalidates the refresh token issued alongside this access token
+	err := u.tokenRepo.RevokeTokenFamily(ctx, claims.FamilyID, u.config.JWT.RefreshTokenExpiresIn)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.LogoutResponse{
+		Message: "Logged out successfully",
+	}, nil
+}
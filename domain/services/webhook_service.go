@@ -0,0 +1,375 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/url"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type WebhookService interface {
+	Create(ctx context.Context, req *requests.CreateWebhookRequest, userID string) (*responses.CreateWebhookResponse, *errutils.Error)
+	List(ctx context.Context, req *requests.ListWebhooksRequest) ([]*models.Webhook, *errutils.Error)
+	Delete(ctx context.Context, req *requests.DeleteWebhookRequest) (*responses.DeleteWebhookResponse, *errutils.Error)
+	ListDeliveries(ctx context.Context, req *requests.ListWebhookDeliveriesRequest) (*responses.ListWebhookDeliveriesResponse, *errutils.Error)
+	Redeliver(ctx context.Context, req *requests.RedeliverWebhookRequest) (*models.WebhookDelivery, *errutils.Error)
+	Dispatch(ctx context.Context, event models.WebhookEvent, projectID bson.ObjectID, data interface{}, actorID bson.ObjectID)
+	ProcessDueDeliveries(ctx context.Context) error
+}
+
+type webhookServiceImpl struct {
+	webhookRepo         repositories.WebhookRepository
+	webhookDeliveryRepo repositories.WebhookDeliveryRepository
+	webhookSender       repositories.WebhookSender
+	projectRepo         repositories.ProjectRepository
+	config              *config.Config
+}
+
+func NewWebhookService(
+	webhookRepo repositories.WebhookRepository,
+	webhookDeliveryRepo repositories.WebhookDeliveryRepository,
+	webhookSender repositories.WebhookSender,
+	projectRepo repositories.ProjectRepository,
+	config *config.Config,
+) WebhookService {
+	return &webhookServiceImpl{
+		webhookRepo:         webhookRepo,
+		webhookDeliveryRepo: webhookDeliveryRepo,
+		webhookSender:       webhookSender,
+		projectRepo:         projectRepo,
+		config:              config,
+	}
+}
+
+// webhookPayload is the envelope posted to every webhook, data holds the changed resource
+type webhookPayload struct {
+	Event     models.WebhookEvent `json:"event"`
+	ProjectID bson.ObjectID       `json:"projectId"`
+	ActorID   bson.ObjectID       `json:"actorId"`
+	Data      interface{}         `json:"data"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+func (s *webhookServiceImpl) Create(ctx context.Context, req *requests.CreateWebhookRequest, userID string) (*responses.CreateWebhookResponse, *errutils.Error) {
+	bsonUserID, err := bson.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	parsedURL, err := url.Parse(req.URL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return nil, errutils.NewError(exceptions.ErrInvalidWebhookURL, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("invalid webhook url: %s", req.URL))
+	}
+
+	// Webhooks are sent from inside our network, so they must not be able to reach internal services
+	if err := s.webhookSender.CheckURL(ctx, req.URL); err != nil {
+		return nil, errutils.NewError(exceptions.ErrWebhookURLNotAllowed, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	events := make([]models.WebhookEvent, 0, len(req.Events))
+	for _, event := range req.Events {
+		webhookEvent := models.WebhookEvent(event)
+		if !webhookEvent.IsValid() {
+			return nil, errutils.NewError(exceptions.ErrInvalidWebhookEvent, errutils.BadRequest).WithDebugMessage(fmt.Sprintf("invalid webhook event: %s", event))
+		}
+		events = append(events, webhookEvent)
+	}
+
+	project, err := s.projectRepo.FindByProjectID(ctx, bsonProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if project == nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.NotFound).WithDebugMessage("project not found")
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	webhook, err := s.webhookRepo.Create(ctx, &repositories.CreateWebhookRequest{
+		ProjectID: bsonProjectID,
+		URL:       req.URL,
+		Secret:    secret,
+		Events:    events,
+		CreatedBy: bsonUserID,
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.CreateWebhookResponse{
+		Webhook: webhook,
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (s *webhookServiceImpl) List(ctx context.Context, req *requests.ListWebhooksRequest) ([]*models.Webhook, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(req.ProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	webhooks, err := s.webhookRepo.FindByProjectID(ctx, bsonProjectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return webhooks, nil
+}
+
+func (s *webhookServiceImpl) Delete(ctx context.Context, req *requests.DeleteWebhookRequest) (*responses.DeleteWebhookResponse, *errutils.Error) {
+	webhook, errRes := s.findProjectWebhook(ctx, req.ProjectID, req.WebhookID)
+	if errRes != nil {
+		return nil, errRes
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhook.ID); err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.DeleteWebhookResponse{
+		Message: "Webhook deleted successfully",
+	}, nil
+}
+
+// findProjectWebhook makes sure the webhook belongs to the project in the path,
+// since the route permission is only checked against the project
+func (s *webhookServiceImpl) findProjectWebhook(ctx context.Context, projectID string, webhookID string) (*models.Webhook, *errutils.Error) {
+	bsonProjectID, err := bson.ObjectIDFromHex(projectID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrProjectNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	bsonWebhookID, err := bson.ObjectIDFromHex(webhookID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrWebhookNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	webhook, err := s.webhookRepo.FindByID(ctx, bsonWebhookID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if webhook == nil || webhook.ProjectID != bsonProjectID {
+		return nil, errutils.NewError(exceptions.ErrWebhookNotFound, errutils.NotFound).WithDebugMessage("webhook not found")
+	}
+
+	return webhook, nil
+}
+
+func normalizeListWebhookDeliveriesPaginationRequest(req *requests.PaginationRequest) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 100
+	}
+	req.SortBy = constant.WebhookDeliveryFieldCreatedAt
+	if req.Order == "" {
+		req.Order = constant.DESC
+	}
+}
+
+func (s *webhookServiceImpl) ListDeliveries(ctx context.Context, req *requests.ListWebhookDeliveriesRequest) (*responses.ListWebhookDeliveriesResponse, *errutils.Error) {
+	webhook, errRes := s.findProjectWebhook(ctx, req.ProjectID, req.WebhookID)
+	if errRes != nil {
+		return nil, errRes
+	}
+
+	normalizeListWebhookDeliveriesPaginationRequest(&req.PaginationRequest)
+
+	deliveries, totalDelivery, err := s.webhookDeliveryRepo.Search(ctx, &repositories.SearchWebhookDeliveryRequest{
+		WebhookID: webhook.ID,
+		Status:    models.WebhookDeliveryStatus(req.Status),
+		PaginationRequest: repositories.PaginationRequest{
+			Page:     req.PaginationRequest.Page,
+			PageSize: req.PaginationRequest.PageSize,
+			SortBy:   req.PaginationRequest.SortBy,
+			Order:    req.PaginationRequest.Order,
+		},
+	})
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+
+	return &responses.ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		PaginationResponse: responses.PaginationResponse{
+			Page:      req.PaginationRequest.Page,
+			PageSize:  req.PaginationRequest.PageSize,
+			TotalPage: int(math.Ceil(float64(totalDelivery) / float64(req.PaginationRequest.PageSize))),
+			TotalItem: int(totalDelivery),
+		},
+	}, nil
+}
+
+func (s *webhookServiceImpl) Redeliver(ctx context.Context, req *requests.RedeliverWebhookRequest) (*models.WebhookDelivery, *errutils.Error) {
+	webhook, errRes := s.findProjectWebhook(ctx, req.ProjectID, req.WebhookID)
+	if errRes != nil {
+		return nil, errRes
+	}
+
+	bsonDeliveryID, err := bson.ObjectIDFromHex(req.DeliveryID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrWebhookDeliveryNotFound, errutils.BadRequest).WithDebugMessage(err.Error())
+	}
+
+	delivery, err := s.webhookDeliveryRepo.FindByID(ctx, bsonDeliveryID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if delivery == nil || delivery.WebhookID != webhook.ID {
+		return nil, errutils.NewError(exceptions.ErrWebhookDeliveryNotFound, errutils.NotFound).WithDebugMessage("webhook delivery not found")
+	}
+
+	delivery, err = s.webhookDeliveryRepo.Reset(ctx, delivery.ID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	} else if delivery == nil {
+		return nil, errutils.NewError(exceptions.ErrWebhookDeliveryNotFound, errutils.NotFound).WithDebugMessage("webhook delivery not found")
+	}
+
+	return delivery, nil
+}
+
+// Dispatch queues a delivery for every project webhook subscribed to the event.
+// Deliveries are sent by the background worker, so a failure here is logged instead of failing the mutation.
+func (s *webhookServiceImpl) Dispatch(ctx context.Context, event models.WebhookEvent, projectID bson.ObjectID, data interface{}, actorID bson.ObjectID) {
+	webhooks, err := s.webhookRepo.FindByProjectIDAndEvent(ctx, projectID, event)
+	if err != nil {
+		log.Printf("⚠️ Failed to find webhooks for %s event in project %s: %v\n", event, projectID.Hex(), err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(&webhookPayload{
+		Event:     event,
+		ProjectID: projectID,
+		ActorID:   actorID,
+		Data:      data,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("⚠️ Failed to encode %s webhook payload for project %s: %v\n", event, projectID.Hex(), err)
+		return
+	}
+
+	deliveries := make([]*repositories.CreateWebhookDeliveryRequest, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		deliveries = append(deliveries, &repositories.CreateWebhookDeliveryRequest{
+			WebhookID: webhook.ID,
+			ProjectID: projectID,
+			Event:     event,
+			Payload:   string(payload),
+		})
+	}
+
+	if err := s.webhookDeliveryRepo.CreateMany(ctx, deliveries); err != nil {
+		log.Printf("⚠️ Failed to queue %s webhook deliveries for project %s: %v\n", event, projectID.Hex(), err)
+	}
+}
+
+// ProcessDueDeliveries sends every pending delivery that is due.
+// Failed attempts are retried with exponential backoff until the attempt limit is reached.
+func (s *webhookServiceImpl) ProcessDueDeliveries(ctx context.Context) error {
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		// The lease keeps the delivery away from other workers while it is being sent
+		delivery, err := s.webhookDeliveryRepo.ClaimDue(ctx, now, now.Add(2*s.config.Webhook.RequestTimeout))
+		if err != nil {
+			return err
+		} else if delivery == nil {
+			return nil
+		}
+
+		if err := s.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *webhookServiceImpl) deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	attempts := delivery.Attempts + 1
+
+	webhook, err := s.webhookRepo.FindByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return err
+	} else if webhook == nil {
+		lastError := "webhook was deleted"
+		return s.webhookDeliveryRepo.UpdateAttempt(ctx, &repositories.UpdateWebhookDeliveryAttemptRequest{
+			ID:        delivery.ID,
+			Status:    models.WebhookDeliveryStatusFailed,
+			Attempts:  attempts,
+			LastError: &lastError,
+		})
+	}
+
+	statusCode, err := s.webhookSender.Send(ctx, &repositories.SendWebhookRequest{
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		Event:      delivery.Event,
+		DeliveryID: delivery.ID,
+		Payload:    []byte(delivery.Payload),
+	})
+
+	update := &repositories.UpdateWebhookDeliveryAttemptRequest{
+		ID:       delivery.ID,
+		Attempts: attempts,
+	}
+	if statusCode != 0 {
+		update.ResponseStatus = &statusCode
+	}
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		update.Status = models.WebhookDeliveryStatusSucceeded
+		return s.webhookDeliveryRepo.UpdateAttempt(ctx, update)
+	}
+
+	lastError := fmt.Sprintf("unexpected response status %d", statusCode)
+	if err != nil {
+		lastError = err.Error()
+	}
+	update.LastError = &lastError
+
+	if attempts >= s.config.Webhook.MaxAttempts {
+		update.Status = models.WebhookDeliveryStatusFailed
+	} else {
+		nextAttemptAt := time.Now().Add(s.config.Webhook.InitialBackoff * time.Duration(1<<(attempts-1)))
+		update.Status = models.WebhookDeliveryStatusPending
+		update.NextAttemptAt = &nextAttemptAt
+	}
+
+	return s.webhookDeliveryRepo.UpdateAttempt(ctx, update)
+}
@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/webhook"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type fakeWebhookRepo struct {
+	repositories.WebhookRepository
+	webhooks map[bson.ObjectID]*models.Webhook
+}
+
+func (f *fakeWebhookRepo) Create(ctx context.Context, in *repositories.CreateWebhookRequest) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		ID:        bson.NewObjectID(),
+		ProjectID: in.ProjectID,
+		URL:       in.URL,
+		Secret:    in.Secret,
+		Events:    in.Events,
+		CreatedAt: time.Now(),
+		CreatedBy: in.CreatedBy,
+	}
+	f.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (f *fakeWebhookRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models.Webhook, error) {
+	return f.webhooks[id], nil
+}
+
+type fakeWebhookDeliveryRepo struct {
+	repositories.WebhookDeliveryRepository
+	mu         sync.Mutex
+	deliveries map[bson.ObjectID]*models.WebhookDelivery
+}
+
+func (f *fakeWebhookDeliveryRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delivery, ok := f.deliveries[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+func (f *fakeWebhookDeliveryRepo) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time) (*models.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, delivery := range f.deliveries {
+		if delivery.Status != models.WebhookDeliveryStatusPending {
+			continue
+		}
+		if delivery.NextAttemptAt != nil && delivery.NextAttemptAt.After(now) {
+			continue
+		}
+
+		delivery.NextAttemptAt = &leaseUntil
+		copied := *delivery
+		return &copied, nil
+	}
+
+	return nil, nil
+}
+
+func (f *fakeWebhookDeliveryRepo) UpdateAttempt(ctx context.Context, in *repositories.UpdateWebhookDeliveryAttemptRequest) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delivery := f.deliveries[in.ID]
+	now := time.Now()
+	delivery.Status = in.Status
+	delivery.Attempts = in.Attempts
+	delivery.NextAttemptAt = in.NextAttemptAt
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = in.ResponseStatus
+	delivery.LastError = in.LastError
+	return nil
+}
+
+func (f *fakeWebhookDeliveryRepo) Reset(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delivery, ok := f.deliveries[id]
+	if !ok {
+		return nil, nil
+	}
+	now := time.Now()
+	delivery.Status = models.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+	copied := *delivery
+	return &copied, nil
+}
+
+// makeDue moves the next attempt into the past so the next ProcessDueDeliveries call picks the delivery up
+func (f *fakeWebhookDeliveryRepo) makeDue(id bson.ObjectID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	past := time.Now().Add(-time.Second)
+	f.deliveries[id].NextAttemptAt = &past
+}
+
+type fakeWebhookProjectRepo struct {
+	repositories.ProjectRepository
+}
+
+func (f *fakeWebhookProjectRepo) FindByProjectID(ctx context.Context, projectID bson.ObjectID) (*models.Project, error) {
+	return &models.Project{ID: projectID}, nil
+}
+
+type webhookServiceFixture struct {
+	service      WebhookService
+	webhookRepo  *fakeWebhookRepo
+	deliveryRepo *fakeWebhookDeliveryRepo
+	config       *config.Config
+}
+
+func newWebhookServiceFixture(allowPrivateNetworks bool) *webhookServiceFixture {
+	cfg := &config.Config{
+		Webhook: config.WebhookConfig{
+			MaxAttempts:          3,
+			InitialBackoff:       time.Minute,
+			RequestTimeout:       5 * time.Second,
+			AllowPrivateNetworks: allowPrivateNetworks,
+		},
+	}
+	webhookRepo := &fakeWebhookRepo{webhooks: make(map[bson.ObjectID]*models.Webhook)}
+	deliveryRepo := &fakeWebhookDeliveryRepo{deliveries: make(map[bson.ObjectID]*models.WebhookDelivery)}
+
+	return &webhookServiceFixture{
+		service:      NewWebhookService(webhookRepo, deliveryRepo, webhook.NewHttpWebhookSender(cfg), &fakeWebhookProjectRepo{}, cfg),
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		config:       cfg,
+	}
+}
+
+// addDelivery stores a webhook pointing at url and a pending delivery for it
+func (f *webhookServiceFixture) addDelivery(url string) (*models.Webhook, *models.WebhookDelivery) {
+	hook := &models.Webhook{
+		ID:        bson.NewObjectID(),
+		ProjectID: bson.NewObjectID(),
+		URL:       url,
+		Secret:    "secret",
+		Events:    []models.WebhookEvent{models.WebhookEventTaskCreated},
+	}
+	f.webhookRepo.webhooks[hook.ID] = hook
+
+	delivery := &models.WebhookDelivery{
+		ID:        bson.NewObjectID(),
+		WebhookID: hook.ID,
+		ProjectID: hook.ProjectID,
+		Event:     models.WebhookEventTaskCreated,
+		Payload:   `{"event":"task.created"}`,
+		Status:    models.WebhookDeliveryStatusPending,
+	}
+	f.deliveryRepo.deliveries[delivery.ID] = delivery
+
+	return hook, delivery
+}
+
+func assertServiceError(t *testing.T, got *errutils.Error, wantErr error, wantStatus errutils.ErrorStatus) {
+	t.Helper()
+
+	if got == nil {
+		t.Fatalf("error = nil, want %v", wantErr)
+	}
+	if got.Message != wantErr.Error() || got.Status != wantStatus {
+		t.Fatalf("error = %s (%s), want %v (%s)", got.Message, got.Status, wantErr, wantStatus)
+	}
+}
+
+func TestWebhookServiceCreateRejectsPrivateURL(t *testing.T) {
+	fixture := newWebhookServiceFixture(false)
+
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://10.1.2.3/hook", "http://169.254.169.254/latest"} {
+		_, err := fixture.service.Create(context.Background(), &requests.CreateWebhookRequest{
+			ProjectID: bson.NewObjectID().Hex(),
+			URL:       url,
+			Events:    []string{models.WebhookEventTaskCreated.String()},
+		}, bson.NewObjectID().Hex())
+		assertServiceError(t, err, exceptions.ErrWebhookURLNotAllowed, errutils.BadRequest)
+	}
+}
+
+func TestWebhookServiceDeliversSignedPayload(t *testing.T) {
+	fixture := newWebhookServiceFixture(true)
+
+	var gotSignature, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		gotSignature = r.Header.Get(webhook.HeaderSignature)
+	}))
+	defer server.Close()
+
+	hook, delivery := fixture.addDelivery(server.URL)
+
+	if err := fixture.service.ProcessDueDeliveries(context.Background()); err != nil {
+		t.Fatalf("ProcessDueDeliveries() error = %v", err)
+	}
+
+	if gotBody != delivery.Payload {
+		t.Fatalf("body = %s, want %s", gotBody, delivery.Payload)
+	}
+	if want := "sha256=" + webhook.Sign(hook.Secret, []byte(delivery.Payload)); gotSignature != want {
+		t.Fatalf("signature = %s, want %s", gotSignature, want)
+	}
+
+	got := fixture.deliveryRepo.deliveries[delivery.ID]
+	if got.Status != models.WebhookDeliveryStatusSucceeded || got.Attempts != 1 {
+		t.Fatalf("delivery = %s after %d attempts, want %s after 1", got.Status, got.Attempts, models.WebhookDeliveryStatusSucceeded)
+	}
+}
+
+func TestWebhookServiceRetriesWithBackoffUntilMaxAttempts(t *testing.T) {
+	fixture := newWebhookServiceFixture(true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, delivery := fixture.addDelivery(server.URL)
+
+	for attempt := 1; attempt <= fixture.config.Webhook.MaxAttempts; attempt++ {
+		startedAt := time.Now()
+		if err := fixture.service.ProcessDueDeliveries(context.Background()); err != nil {
+			t.Fatalf("ProcessDueDeliveries() error = %v", err)
+		}
+
+		got := fixture.deliveryRepo.deliveries[delivery.ID]
+		if got.Attempts != attempt {
+			t.Fatalf("attempts = %d, want %d", got.Attempts, attempt)
+		}
+		if got.ResponseStatus == nil || *got.ResponseStatus != http.StatusInternalServerError {
+			t.Fatalf("response status = %v, want %d", got.ResponseStatus, http.StatusInternalServerError)
+		}
+
+		if attempt == fixture.config.Webhook.MaxAttempts {
+			if got.Status != models.WebhookDeliveryStatusFailed {
+				t.Fatalf("status = %s, want %s", got.Status, models.WebhookDeliveryStatusFailed)
+			}
+			break
+		}
+
+		if got.Status != models.WebhookDeliveryStatusPending {
+			t.Fatalf("status = %s, want %s", got.Status, models.WebhookDeliveryStatusPending)
+		}
+
+		// The backoff doubles after every failed attempt
+		wantBackoff := fixture.config.Webhook.InitialBackoff * time.Duration(1<<(attempt-1))
+		if got.NextAttemptAt == nil || got.NextAttemptAt.Before(startedAt.Add(wantBackoff)) || got.NextAttemptAt.After(time.Now().Add(wantBackoff)) {
+			t.Fatalf("next attempt at %v, want %v after %v", got.NextAttemptAt, wantBackoff, startedAt)
+		}
+
+		// The delivery is not due before its backoff is over
+		if err := fixture.service.ProcessDueDeliveries(context.Background()); err != nil {
+			t.Fatalf("ProcessDueDeliveries() error = %v", err)
+		}
+		if got := fixture.deliveryRepo.deliveries[delivery.ID]; got.Attempts != attempt {
+			t.Fatalf("attempts = %d before the backoff was over, want %d", got.Attempts, attempt)
+		}
+
+		fixture.deliveryRepo.makeDue(delivery.ID)
+	}
+}
+
+func TestWebhookServiceRedeliver(t *testing.T) {
+	fixture := newWebhookServiceFixture(true)
+
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	hook, delivery := fixture.addDelivery(server.URL)
+	for attempt := 0; attempt < fixture.config.Webhook.MaxAttempts; attempt++ {
+		fixture.deliveryRepo.makeDue(delivery.ID)
+		if err := fixture.service.ProcessDueDeliveries(context.Background()); err != nil {
+			t.Fatalf("ProcessDueDeliveries() error = %v", err)
+		}
+	}
+	if got := fixture.deliveryRepo.deliveries[delivery.ID]; got.Status != models.WebhookDeliveryStatusFailed {
+		t.Fatalf("status = %s, want %s", got.Status, models.WebhookDeliveryStatusFailed)
+	}
+
+	redelivered, err := fixture.service.Redeliver(context.Background(), &requests.RedeliverWebhookRequest{
+		ProjectID:  hook.ProjectID.Hex(),
+		WebhookID:  hook.ID.Hex(),
+		DeliveryID: delivery.ID.Hex(),
+	})
+	if err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	if redelivered.Status != models.WebhookDeliveryStatusPending || redelivered.Attempts != 0 {
+		t.Fatalf("redelivered = %s after %d attempts, want %s after 0", redelivered.Status, redelivered.Attempts, models.WebhookDeliveryStatusPending)
+	}
+
+	status = http.StatusOK
+	if err := fixture.service.ProcessDueDeliveries(context.Background()); err != nil {
+		t.Fatalf("ProcessDueDeliveries() error = %v", err)
+	}
+	if got := fixture.deliveryRepo.deliveries[delivery.ID]; got.Status != models.WebhookDeliveryStatusSucceeded || got.Attempts != 1 {
+		t.Fatalf("delivery = %s after %d attempts, want %s after 1", got.Status, got.Attempts, models.WebhookDeliveryStatusSucceeded)
+	}
+}
+
+func TestWebhookServiceRedeliverChecksProject(t *testing.T) {
+	fixture := newWebhookServiceFixture(true)
+	hook, delivery := fixture.addDelivery("http://example.com/hook")
+	otherHook, _ := fixture.addDelivery("http://example.com/other")
+
+	_, err := fixture.service.Redeliver(context.Background(), &requests.RedeliverWebhookRequest{
+		ProjectID:  bson.NewObjectID().Hex(),
+		WebhookID:  hook.ID.Hex(),
+		DeliveryID: delivery.ID.Hex(),
+	})
+	assertServiceError(t, err, exceptions.ErrWebhookNotFound, errutils.NotFound)
+
+	_, err = fixture.service.Redeliver(context.Background(), &requests.RedeliverWebhookRequest{
+		ProjectID:  otherHook.ProjectID.Hex(),
+		WebhookID:  otherHook.ID.Hex(),
+		DeliveryID: delivery.ID.Hex(),
+	})
+	assertServiceError(t, err, exceptions.ErrWebhookDeliveryNotFound, errutils.NotFound)
+}
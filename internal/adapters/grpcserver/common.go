@@ -0,0 +1,199 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/cnc-csku/task-nexus-go-lib/jsonvalidator"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/middlewares"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The task, project and sprint RPCs are not part of the published API specification yet,
+// so their messages are google.protobuf.Struct holding the same JSON as the REST API.
+// Path params of the REST API are plain fields of the request struct, e.g. {"projectId": "..."}.
+
+var validator = jsonvalidator.NewValidator()
+
+type structHandler func(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+
+// structMethod builds a unary method that runs through the server interceptors like generated code does
+func structMethod(serviceName string, methodName string, handler structHandler) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: methodName,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := new(structpb.Struct)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return handler(ctx, in)
+			}
+
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + serviceName + "/" + methodName,
+			}
+			return interceptor(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return handler(ctx, req.(*structpb.Struct))
+			})
+		},
+	}
+}
+
+// handle decodes and validates the request, applies the same permission check as the REST route and calls the service
+func handle[Req any, Res any](
+	ctx context.Context,
+	in *structpb.Struct,
+	authorizationService services.AuthorizationService,
+	permission models.Permission,
+	call func(ctx context.Context, req *Req, userID string) (Res, *errutils.Error),
+) (*structpb.Struct, error) {
+	req := new(Req)
+	if err := decodePayload(in, req); err != nil {
+		return nil, err
+	}
+
+	if serviceErr := validator.ValidateStruct(req); serviceErr != nil {
+		return nil, toGrpcError(serviceErr)
+	}
+
+	// The IDs are read from the decoded request, since JSON field matching is case-insensitive and the last
+	// duplicate wins, the raw fields could name a different resource than the one the service acts on
+	claims, err := authorize(ctx, scopeOf(req), authorizationService, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	res, serviceErr := call(ctx, req, claims.ID)
+	if serviceErr != nil {
+		return nil, toGrpcError(serviceErr)
+	}
+
+	return encodePayload(res)
+}
+
+// authorizationScope holds the IDs of the resources a permission is checked against
+type authorizationScope struct {
+	WorkspaceID string
+	ProjectID   string
+	TaskID      string
+}
+
+// scopeOf reads the WorkspaceID, ProjectID and TaskID fields of a decoded request
+func scopeOf(req interface{}) authorizationScope {
+	value := reflect.Indirect(reflect.ValueOf(req))
+	if value.Kind() != reflect.Struct {
+		return authorizationScope{}
+	}
+
+	stringField := func(name string) string {
+		field := value.FieldByName(name)
+		if !field.IsValid() || field.Kind() != reflect.String {
+			return ""
+		}
+		return field.String()
+	}
+
+	return authorizationScope{
+		WorkspaceID: stringField("WorkspaceID"),
+		ProjectID:   stringField("ProjectID"),
+		TaskID:      stringField("TaskID"),
+	}
+}
+
+// authorize mirrors PermissionMiddleware, project scoped permissions are checked through the task when it is present
+func authorize(ctx context.Context, scope authorizationScope, authorizationService services.AuthorizationService, permission models.Permission) (*models.UserCustomClaims, error) {
+	claims, ok := middlewares.GetProfileOnGrpcContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, exceptions.ErrInvalidToken.Error())
+	}
+
+	var serviceErr *errutils.Error
+	switch permission.Scope() {
+	case models.PermissionScopeWorkspace:
+		_, serviceErr = authorizationService.AuthorizeWorkspace(ctx, scope.WorkspaceID, claims.ID, permission)
+	case models.PermissionScopeProject:
+		if scope.TaskID != "" {
+			_, serviceErr = authorizationService.AuthorizeTask(ctx, scope.TaskID, claims.ID, permission)
+		} else {
+			projectID := scope.ProjectID
+			if projectID == "" {
+				return nil, status.Error(codes.InvalidArgument, "projectId is required")
+			}
+			_, serviceErr = authorizationService.AuthorizeProject(ctx, projectID, claims.ID, permission)
+		}
+	default:
+		return nil, status.Errorf(codes.Internal, "unknown permission: %s", permission)
+	}
+	if serviceErr != nil {
+		return nil, toGrpcError(serviceErr)
+	}
+
+	return claims, nil
+}
+
+func decodePayload(in *structpb.Struct, out interface{}) error {
+	payload, err := protojson.Marshal(in)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := json.Unmarshal(payload, out); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// encodePayload converts a response to a Struct, lists are wrapped as {"items": [...]} since a Struct must be an object
+func encodePayload(in interface{}) (*structpb.Struct, error) {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if bytes.HasPrefix(payload, []byte("[")) {
+		payload, err = json.Marshal(map[string]json.RawMessage{"items": payload})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	out := new(structpb.Struct)
+	if err := protojson.Unmarshal(payload, out); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return out, nil
+}
+
+func toGrpcError(err *errutils.Error) error {
+	code := codes.Internal
+	switch err.Status {
+	case errutils.BadRequest, errutils.UnprocessableEntity, errutils.UnsupportedMediaType:
+		code = codes.InvalidArgument
+	case errutils.Unauthorized:
+		code = codes.Unauthenticated
+	case errutils.Forbidden:
+		code = codes.PermissionDenied
+	case errutils.NotFound:
+		code = codes.NotFound
+	case errutils.Conflict:
+		code = codes.AlreadyExists
+	case errutils.TooManyRequests:
+		code = codes.ResourceExhausted
+	}
+
+	return status.Error(code, err.Message)
+}
@@ -0,0 +1,130 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/middlewares"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeAuthorizationService only lets the caller into allowedProjectID and allowedTaskID
+type fakeAuthorizationService struct {
+	allowedProjectID string
+	allowedTaskID    string
+}
+
+func (f *fakeAuthorizationService) AuthorizeWorkspace(ctx context.Context, workspaceID string, userID string, permission models.Permission) (*models.WorkspaceMember, *errutils.Error) {
+	return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden)
+}
+
+func (f *fakeAuthorizationService) AuthorizeProject(ctx context.Context, projectID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error) {
+	if projectID != f.allowedProjectID {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden)
+	}
+	return &models.ProjectMember{}, nil
+}
+
+func (f *fakeAuthorizationService) AuthorizeTask(ctx context.Context, taskID string, userID string, permission models.Permission) (*models.ProjectMember, *errutils.Error) {
+	if taskID != f.allowedTaskID {
+		return nil, errutils.NewError(exceptions.ErrPermissionDenied, errutils.Forbidden)
+	}
+	return &models.ProjectMember{}, nil
+}
+
+func newAuthorizedContext() context.Context {
+	return middlewares.NewGrpcProfileContext(context.Background(), &models.UserCustomClaims{ID: "caller"})
+}
+
+func newStruct(t *testing.T, fields map[string]interface{}) *structpb.Struct {
+	t.Helper()
+
+	in, err := structpb.NewStruct(fields)
+	if err != nil {
+		t.Fatalf("structpb.NewStruct() error = %v", err)
+	}
+	return in
+}
+
+func TestHandleAuthorizesTheDecodedProject(t *testing.T) {
+	authorizationService := &fakeAuthorizationService{allowedProjectID: "mine"}
+
+	tests := []struct {
+		name          string
+		fields        map[string]interface{}
+		wantCode      codes.Code
+		wantProjectID string
+	}{
+		{
+			name:          "own project",
+			fields:        map[string]interface{}{"projectId": "mine"},
+			wantCode:      codes.OK,
+			wantProjectID: "mine",
+		},
+		{
+			name:     "other project",
+			fields:   map[string]interface{}{"projectId": "victim"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "other project under a differently cased key",
+			fields:   map[string]interface{}{"projectId": "mine", "projectid": "victim"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "only a differently cased key",
+			fields:   map[string]interface{}{"ProjectID": "victim"},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calledWith *requests.ListTasksRequest
+			_, err := handle(newAuthorizedContext(), newStruct(t, tt.fields), authorizationService, models.PermissionTaskView,
+				func(ctx context.Context, req *requests.ListTasksRequest, userID string) (map[string]string, *errutils.Error) {
+					calledWith = req
+					return map[string]string{}, nil
+				},
+			)
+
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("handle() code = %s, want %s (%v)", got, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				if calledWith != nil {
+					t.Fatalf("service was called with project %s", calledWith.ProjectID)
+				}
+				return
+			}
+			if calledWith == nil || calledWith.ProjectID != tt.wantProjectID {
+				t.Fatalf("service was called with %+v, want project %s", calledWith, tt.wantProjectID)
+			}
+		})
+	}
+}
+
+func TestHandleAuthorizesTheDecodedTask(t *testing.T) {
+	authorizationService := &fakeAuthorizationService{allowedTaskID: "MINE-1"}
+
+	called := false
+	_, err := handle(newAuthorizedContext(), newStruct(t, map[string]interface{}{"taskId": "MINE-1", "taskid": "VICTIM-1"}), authorizationService, models.PermissionTaskView,
+		func(ctx context.Context, req *requests.GetTaskDetailPathParam, userID string) (map[string]string, *errutils.Error) {
+			called = true
+			return map[string]string{}, nil
+		},
+	)
+
+	if got := status.Code(err); got != codes.PermissionDenied {
+		t.Fatalf("handle() code = %s, want %s (%v)", got, codes.PermissionDenied, err)
+	}
+	if called {
+		t.Fatal("service was called for a task the caller cannot view")
+	}
+}
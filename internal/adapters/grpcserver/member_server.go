@@ -0,0 +1,72 @@
+package grpcserver
+
+import (
+	"context"
+
+	taskv1 "github.com/cnc-csku/task-nexus-api-specification/gen/proto/task/v1"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// memberPageSize is the page size used to collect every member of a project
+const memberPageSize = 100
+
+type MemberServer interface {
+	taskv1.MemberServiceServer
+	Register(registrar grpc.ServiceRegistrar)
+}
+
+type memberServerImpl struct {
+	taskv1.UnimplementedMemberServiceServer
+	projectService       services.ProjectService
+	authorizationService services.AuthorizationService
+}
+
+func NewMemberServer(projectService services.ProjectService, authorizationService services.AuthorizationService) MemberServer {
+	return &memberServerImpl{
+		projectService:       projectService,
+		authorizationService: authorizationService,
+	}
+}
+
+func (s *memberServerImpl) Register(registrar grpc.ServiceRegistrar) {
+	taskv1.RegisterMemberServiceServer(registrar, s)
+}
+
+// GetMembers returns every member of the project with the given id
+func (s *memberServerImpl) GetMembers(ctx context.Context, req *taskv1.GetMembersRequest) (*taskv1.GetMembersResponse, error) {
+	if _, err := authorize(ctx, authorizationScope{ProjectID: req.GetId()}, s.authorizationService, models.PermissionProjectView); err != nil {
+		return nil, err
+	}
+
+	res := &taskv1.GetMembersResponse{}
+	for page := 1; ; page++ {
+		members, serviceErr := s.projectService.ListMembers(ctx, &requests.ListProjectMembersRequest{
+			ProjectID: req.GetId(),
+			PaginationRequest: requests.PaginationRequest{
+				Page:     page,
+				PageSize: memberPageSize,
+			},
+		})
+		if serviceErr != nil {
+			return nil, toGrpcError(serviceErr)
+		}
+
+		for _, member := range members.Members {
+			res.Members = append(res.Members, &taskv1.GetMembersResponse_Member{
+				Id:        member.UserID,
+				Name:      member.DisplayName,
+				CreatedAt: timestamppb.New(member.JoinedAt),
+			})
+		}
+
+		if members.PaginationResponse == nil || page >= members.PaginationResponse.TotalPage {
+			break
+		}
+	}
+
+	return res, nil
+}
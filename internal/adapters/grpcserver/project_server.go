@@ -0,0 +1,78 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const projectServiceName = "task.v1.ProjectService"
+
+type ProjectServer interface {
+	Register(registrar grpc.ServiceRegistrar)
+	ListMyProjects(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	GetProject(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	ListProjectMembers(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	AddProjectMembers(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	ListWorkflows(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+}
+
+type projectServerImpl struct {
+	projectService       services.ProjectService
+	authorizationService services.AuthorizationService
+}
+
+func NewProjectServer(projectService services.ProjectService, authorizationService services.AuthorizationService) ProjectServer {
+	return &projectServerImpl{
+		projectService:       projectService,
+		authorizationService: authorizationService,
+	}
+}
+
+func (s *projectServerImpl) Register(registrar grpc.ServiceRegistrar) {
+	registrar.RegisterService(&grpc.ServiceDesc{
+		ServiceName: projectServiceName,
+		HandlerType: (*ProjectServer)(nil),
+		Methods: []grpc.MethodDesc{
+			structMethod(projectServiceName, "ListMyProjects", s.ListMyProjects),
+			structMethod(projectServiceName, "GetProject", s.GetProject),
+			structMethod(projectServiceName, "ListProjectMembers", s.ListProjectMembers),
+			structMethod(projectServiceName, "AddProjectMembers", s.AddProjectMembers),
+			structMethod(projectServiceName, "ListWorkflows", s.ListWorkflows),
+		},
+	}, s)
+}
+
+func (s *projectServerImpl) ListMyProjects(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionWorkspaceView, s.projectService.ListMyProjects)
+}
+
+func (s *projectServerImpl) GetProject(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionProjectView, s.projectService.GetProjectDetail)
+}
+
+func (s *projectServerImpl) ListProjectMembers(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionProjectView,
+		func(ctx context.Context, req *requests.ListProjectMembersRequest, userID string) (*responses.ListProjectMembersResponse, *errutils.Error) {
+			return s.projectService.ListMembers(ctx, req)
+		},
+	)
+}
+
+func (s *projectServerImpl) AddProjectMembers(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionProjectManage, s.projectService.AddMembers)
+}
+
+func (s *projectServerImpl) ListWorkflows(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionProjectView,
+		func(ctx context.Context, req *requests.ListWorkflowsPathParams, userID string) ([]models.Workflow, *errutils.Error) {
+			return s.projectService.ListWorkflows(ctx, req)
+		},
+	)
+}
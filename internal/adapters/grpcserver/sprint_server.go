@@ -0,0 +1,86 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const sprintServiceName = "task.v1.SprintService"
+
+type SprintServer interface {
+	Register(registrar grpc.ServiceRegistrar)
+	CreateSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	GetSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	ListSprints(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	EditSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	StartSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	CompleteSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	MoveTasksToSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+}
+
+type sprintServerImpl struct {
+	sprintService        services.SprintService
+	authorizationService services.AuthorizationService
+}
+
+func NewSprintServer(sprintService services.SprintService, authorizationService services.AuthorizationService) SprintServer {
+	return &sprintServerImpl{
+		sprintService:        sprintService,
+		authorizationService: authorizationService,
+	}
+}
+
+func (s *sprintServerImpl) Register(registrar grpc.ServiceRegistrar) {
+	registrar.RegisterService(&grpc.ServiceDesc{
+		ServiceName: sprintServiceName,
+		HandlerType: (*SprintServer)(nil),
+		Methods: []grpc.MethodDesc{
+			structMethod(sprintServiceName, "CreateSprint", s.CreateSprint),
+			structMethod(sprintServiceName, "GetSprint", s.GetSprint),
+			structMethod(sprintServiceName, "ListSprints", s.ListSprints),
+			structMethod(sprintServiceName, "EditSprint", s.EditSprint),
+			structMethod(sprintServiceName, "StartSprint", s.StartSprint),
+			structMethod(sprintServiceName, "CompleteSprint", s.CompleteSprint),
+			structMethod(sprintServiceName, "MoveTasksToSprint", s.MoveTasksToSprint),
+		},
+	}, s)
+}
+
+func (s *sprintServerImpl) CreateSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintCreate, s.sprintService.Create)
+}
+
+// GetSprint requires projectId next to sprintId, the same as the REST route
+func (s *sprintServerImpl) GetSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintView,
+		func(ctx context.Context, req *requests.GetSprintByIDRequest, userID string) (*models.Sprint, *errutils.Error) {
+			return s.sprintService.GetByID(ctx, req)
+		},
+	)
+}
+
+func (s *sprintServerImpl) ListSprints(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintView, s.sprintService.List)
+}
+
+func (s *sprintServerImpl) EditSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintEdit, s.sprintService.Edit)
+}
+
+func (s *sprintServerImpl) StartSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintEdit, s.sprintService.Start)
+}
+
+func (s *sprintServerImpl) CompleteSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionSprintEdit, s.sprintService.Complete)
+}
+
+func (s *sprintServerImpl) MoveTasksToSprint(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.sprintService.MoveTasks)
+}
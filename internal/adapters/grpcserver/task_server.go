@@ -0,0 +1,85 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const taskServiceName = "task.v1.TaskService"
+
+type TaskServer interface {
+	Register(registrar grpc.ServiceRegistrar)
+	CreateTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	GetTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	ListTasks(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	UpdateTaskDetail(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	UpdateTaskStatus(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	AddTaskAssignees(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	ReplaceTaskAssignees(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+	RemoveTaskAssignee(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error)
+}
+
+type taskServerImpl struct {
+	taskService          services.TaskService
+	authorizationService services.AuthorizationService
+}
+
+func NewTaskServer(taskService services.TaskService, authorizationService services.AuthorizationService) TaskServer {
+	return &taskServerImpl{
+		taskService:          taskService,
+		authorizationService: authorizationService,
+	}
+}
+
+func (s *taskServerImpl) Register(registrar grpc.ServiceRegistrar) {
+	registrar.RegisterService(&grpc.ServiceDesc{
+		ServiceName: taskServiceName,
+		HandlerType: (*TaskServer)(nil),
+		Methods: []grpc.MethodDesc{
+			structMethod(taskServiceName, "CreateTask", s.CreateTask),
+			structMethod(taskServiceName, "GetTask", s.GetTask),
+			structMethod(taskServiceName, "ListTasks", s.ListTasks),
+			structMethod(taskServiceName, "UpdateTaskDetail", s.UpdateTaskDetail),
+			structMethod(taskServiceName, "UpdateTaskStatus", s.UpdateTaskStatus),
+			structMethod(taskServiceName, "AddTaskAssignees", s.AddTaskAssignees),
+			structMethod(taskServiceName, "ReplaceTaskAssignees", s.ReplaceTaskAssignees),
+			structMethod(taskServiceName, "RemoveTaskAssignee", s.RemoveTaskAssignee),
+		},
+	}, s)
+}
+
+func (s *taskServerImpl) CreateTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskCreate, s.taskService.Create)
+}
+
+func (s *taskServerImpl) GetTask(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskView, s.taskService.GetTaskDetail)
+}
+
+func (s *taskServerImpl) ListTasks(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskView, s.taskService.ListTasks)
+}
+
+func (s *taskServerImpl) UpdateTaskDetail(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.taskService.UpdateDetail)
+}
+
+func (s *taskServerImpl) UpdateTaskStatus(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.taskService.UpdateStatus)
+}
+
+func (s *taskServerImpl) AddTaskAssignees(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.taskService.AddAssignees)
+}
+
+func (s *taskServerImpl) ReplaceTaskAssignees(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.taskService.ReplaceAssignees)
+}
+
+func (s *taskServerImpl) RemoveTaskAssignee(ctx context.Context, in *structpb.Struct) (*structpb.Struct, error) {
+	return handle(ctx, in, s.authorizationService, models.PermissionTaskEdit, s.taskService.RemoveAssignee)
+}
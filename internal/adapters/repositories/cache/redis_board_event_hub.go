@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	boardEventChannelPrefix = "board_events:"
+
+	// boardEventBufferSize bounds how many events can wait for a slow subscriber before new ones are dropped
+	boardEventBufferSize = 64
+)
+
+type redisBoardEventHub struct {
+	client *redis.Client
+}
+
+func NewRedisBoardEventHub(redisClient *redis.Client) repositories.BoardEventHub {
+	return &redisBoardEventHub{
+		client: redisClient,
+	}
+}
+
+func (r *redisBoardEventHub) Publish(ctx context.Context, event *models.BoardEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Publish(ctx, boardEventChannelPrefix+event.ProjectID.Hex(), data).Err()
+}
+
+func (r *redisBoardEventHub) Subscribe(ctx context.Context, projectID bson.ObjectID) (<-chan *models.BoardEvent, func(), error) {
+	pubsub := r.client.Subscribe(ctx, boardEventChannelPrefix+projectID.Hex())
+
+	// Wait for the subscription to be confirmed so no event published after this call is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, nil, err
+	}
+
+	events := make(chan *models.BoardEvent, boardEventBufferSize)
+	go func() {
+		defer close(events)
+
+		for message := range pubsub.Channel() {
+			event := new(models.BoardEvent)
+			if err := json.Unmarshal([]byte(message.Payload), event); err != nil {
+				log.Printf("⚠️ Invalid board event on %s: %v\n", message.Channel, err)
+				continue
+			}
+
+			select {
+			case events <- event:
+			default:
+			}
+		}
+	}()
+
+	unsubscribe := func() {
+		pubsub.Close()
+	}
+
+	return events, unsubscribe, nil
+}
@@ -2,20 +2,59 @@ package grpcclient
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	core_grpcclient "github.com/cnc-csku/task-nexus-go-lib/grpcclient"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type GrpcClient struct {
 	Grpcclient *core_grpcclient.GrpcClient
+
+	// NotificationConn carries the notification RPCs that are not part of the published API specification yet.
+	// It is nil when the notification service is not configured.
+	NotificationConn *grpc.ClientConn
 }
 
 func NewGrpcClient(
 	ctx context.Context,
+	config *config.Config,
 	grpcclient *core_grpcclient.GrpcClient,
 ) *GrpcClient {
 	grpcclient.WithNotificationServiceClient(ctx)
-	return &GrpcClient{
+
+	client := &GrpcClient{
 		Grpcclient: grpcclient,
 	}
+
+	notificationConfig := config.GrpcClient.NotificationService
+	if notificationConfig.Host != "" {
+		// grpc.NewClient does not dial, the connection is made by the first RPC
+		conn, err := grpc.NewClient(
+			fmt.Sprintf("%s:%d", notificationConfig.Host, notificationConfig.Port),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallSendMsgSize(notificationConfig.MaxSendMsgSize*1024*1024),
+				grpc.MaxCallRecvMsgSize(notificationConfig.MaxRecvMsgSize*1024*1024),
+			),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			log.Fatalf("❌ Error creating NotificationService connection: %v\n", err)
+		}
+		client.NotificationConn = conn
+	}
+
+	return client
+}
+
+// Close closes the connections opened by this package, the ones of Grpcclient are closed by core_grpcclient.CloseAllGrpcConnections
+func (g *GrpcClient) Close() error {
+	if g.NotificationConn == nil {
+		return nil
+	}
+
+	return g.NotificationConn.Close()
 }
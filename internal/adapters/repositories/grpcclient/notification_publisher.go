@@ -0,0 +1,66 @@
+package grpcclient
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/memory"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The publish RPC is not part of the published API specification yet, so like the RPCs served by this service
+// its messages are google.protobuf.Struct holding the same JSON as the REST API.
+const notificationPublishMethod = "/notification.v1.NotificationService/PublishNotification"
+
+type grpcNotificationPublisher struct {
+	conn  *grpc.ClientConn
+	local repositories.NotificationPublisher
+}
+
+// NewNotificationPublisher returns the publisher used to push notifications to connected users.
+// Notifications are delivered through the notification service when it is configured, and always to the
+// event streams served by this instance. Whether the service is reachable is reported by the readiness check.
+func NewNotificationPublisher(grpcClient *GrpcClient) repositories.NotificationPublisher {
+	local := memory.NewMemoryNotificationPublisher()
+	if grpcClient.NotificationConn == nil {
+		log.Println("NotificationService is not configured, using in-memory notification publisher")
+		return local
+	}
+
+	return &grpcNotificationPublisher{
+		conn:  grpcClient.NotificationConn,
+		local: local,
+	}
+}
+
+func (g *grpcNotificationPublisher) Publish(ctx context.Context, notification *models.Notification) error {
+	if err := g.local.Publish(ctx, notification); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	in := new(structpb.Struct)
+	if err := protojson.Unmarshal(payload, in); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constant.NotificationPublishTimeout)
+	defer cancel()
+
+	return g.conn.Invoke(ctx, notificationPublishMethod, in, new(structpb.Struct))
+}
+
+func (g *grpcNotificationPublisher) Subscribe(userID bson.ObjectID) (<-chan *models.Notification, func()) {
+	return g.local.Subscribe(userID)
+}
@@ -0,0 +1,87 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newStubNotificationServer serves the publish RPC on a local port and sends every request it receives to the returned channel
+func newStubNotificationServer(t *testing.T) (*GrpcClient, <-chan *structpb.Struct) {
+	t.Helper()
+
+	received := make(chan *structpb.Struct, 1)
+	server := grpc.NewServer()
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "notification.v1.NotificationService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "PublishNotification",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(structpb.Struct)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				received <- in
+				return &structpb.Struct{}, nil
+			},
+		}},
+	}, struct{}{})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	client := &GrpcClient{NotificationConn: conn}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, received
+}
+
+func TestNotificationPublisherDeliversThroughTheNotificationService(t *testing.T) {
+	client, received := newStubNotificationServer(t)
+	publisher := NewNotificationPublisher(client)
+
+	userID := bson.NewObjectID()
+	events, unsubscribe := publisher.Subscribe(userID)
+	defer unsubscribe()
+
+	notification := &models.Notification{
+		ID:      bson.NewObjectID(),
+		UserID:  userID,
+		Type:    models.NotificationTypeTaskApproved,
+		Message: "TN-1 was approved",
+	}
+	if err := publisher.Publish(context.Background(), notification); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	in := <-received
+	if got := in.GetFields()["message"].GetStringValue(); got != notification.Message {
+		t.Fatalf("published message = %q, want %q", got, notification.Message)
+	}
+	if got := <-events; got != notification {
+		t.Fatalf("subscriber received %+v, want %+v", got, notification)
+	}
+}
+
+func TestNotificationPublisherWithoutNotificationService(t *testing.T) {
+	publisher := NewNotificationPublisher(&GrpcClient{})
+
+	if err := publisher.Publish(context.Background(), &models.Notification{UserID: bson.NewObjectID()}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+}
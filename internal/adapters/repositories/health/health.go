@@ -0,0 +1,41 @@
+package health
+
+import (
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/grpcclient"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// NewHealthCheckers returns the checkers used for readiness.
+// The LLM provider and the notification service are optional and only checked when they are configured.
+func NewHealthCheckers(
+	cfg *config.Config,
+	mongoClient *mongo.Client,
+	redisClient *redis.Client,
+	grpcClient *grpcclient.GrpcClient,
+	ollamaClient *llm.OllamaClient,
+	openAIClient *llm.OpenAIClient,
+) []repositories.HealthChecker {
+	checkers := []repositories.HealthChecker{
+		NewMongoHealthChecker(mongoClient),
+		NewRedisHealthChecker(redisClient),
+	}
+
+	switch cfg.LLM.Provider {
+	case config.LLMProviderOllama:
+		if ollamaClient.BaseURL != "" {
+			checkers = append(checkers, NewOllamaHealthChecker(ollamaClient))
+		}
+	case config.LLMProviderOpenAI:
+		checkers = append(checkers, NewOpenAIHealthChecker(openAIClient))
+	}
+
+	if grpcClient.Grpcclient.NotificationService != nil {
+		checkers = append(checkers, NewNotificationHealthChecker(grpcClient))
+	}
+
+	return checkers
+}
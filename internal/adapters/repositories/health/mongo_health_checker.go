@@ -0,0 +1,28 @@
+package health
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+)
+
+type mongoHealthChecker struct {
+	mongoClient *mongo.Client
+}
+
+func NewMongoHealthChecker(mongoClient *mongo.Client) repositories.HealthChecker {
+	return &mongoHealthChecker{
+		mongoClient: mongoClient,
+	}
+}
+
+func (m *mongoHealthChecker) Name() string {
+	return "mongodb"
+}
+
+// Check pings the primary since every write goes there
+func (m *mongoHealthChecker) Check(ctx context.Context) error {
+	return m.mongoClient.Ping(ctx, readpref.Primary())
+}
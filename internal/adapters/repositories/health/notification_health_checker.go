@@ -0,0 +1,28 @@
+package health
+
+import (
+	"context"
+
+	notificationv1 "github.com/cnc-csku/task-nexus-api-specification/gen/proto/notification/v1"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/grpcclient"
+)
+
+type notificationHealthChecker struct {
+	grpcClient *grpcclient.GrpcClient
+}
+
+func NewNotificationHealthChecker(grpcClient *grpcclient.GrpcClient) repositories.HealthChecker {
+	return &notificationHealthChecker{
+		grpcClient: grpcClient,
+	}
+}
+
+func (n *notificationHealthChecker) Name() string {
+	return "notification"
+}
+
+func (n *notificationHealthChecker) Check(ctx context.Context) error {
+	_, err := n.grpcClient.Grpcclient.NotificationService.TestConnection(ctx, &notificationv1.TestConnectionRequest{})
+	return err
+}
@@ -0,0 +1,44 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+type ollamaHealthChecker struct {
+	client *llm.OllamaClient
+}
+
+func NewOllamaHealthChecker(client *llm.OllamaClient) repositories.HealthChecker {
+	return &ollamaHealthChecker{
+		client: client,
+	}
+}
+
+func (o *ollamaHealthChecker) Name() string {
+	return "ollama"
+}
+
+// Check calls the Ollama root endpoint, which answers "Ollama is running"
+func (o *ollamaHealthChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.client.BaseURL, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := o.client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d", res.StatusCode)
+	}
+
+	return nil
+}
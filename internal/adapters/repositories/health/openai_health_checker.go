@@ -0,0 +1,47 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+type openAIHealthChecker struct {
+	client *llm.OpenAIClient
+}
+
+func NewOpenAIHealthChecker(client *llm.OpenAIClient) repositories.HealthChecker {
+	return &openAIHealthChecker{
+		client: client,
+	}
+}
+
+func (o *openAIHealthChecker) Name() string {
+	return "openai"
+}
+
+// Check lists the models, which also verifies the API key
+func (o *openAIHealthChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.client.BaseURL+"/models", nil)
+	if err != nil {
+		return err
+	}
+	if o.client.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.client.APIKey)
+	}
+
+	res, err := o.client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d", res.StatusCode)
+	}
+
+	return nil
+}
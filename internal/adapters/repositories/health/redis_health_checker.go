@@ -0,0 +1,26 @@
+package health
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisHealthChecker struct {
+	redisClient *redis.Client
+}
+
+func NewRedisHealthChecker(redisClient *redis.Client) repositories.HealthChecker {
+	return &redisHealthChecker{
+		redisClient: redisClient,
+	}
+}
+
+func (r *redisHealthChecker) Name() string {
+	return "redis"
+}
+
+func (r *redisHealthChecker) Check(ctx context.Context) error {
+	return r.redisClient.Ping(ctx).Err()
+}
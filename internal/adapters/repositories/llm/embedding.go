@@ -0,0 +1,35 @@
+package llm
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+)
+
+type llmEmbeddingRepo struct {
+	llmRepo repositories.LLMRepository
+	cfg     *config.Config
+}
+
+func NewLLMEmbeddingRepository(llmRepo repositories.LLMRepository, cfg *config.Config) repositories.EmbeddingRepository {
+	return &llmEmbeddingRepo{
+		llmRepo: llmRepo,
+		cfg:     cfg,
+	}
+}
+
+func (r *llmEmbeddingRepo) Configured() bool {
+	return r.llmRepo.Configured()
+}
+
+func (r *llmEmbeddingRepo) Model() string {
+	return r.cfg.LLM.Model.Embedding
+}
+
+func (r *llmEmbeddingRepo) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	return r.llmRepo.Embed(ctx, &repositories.LLMEmbedRequest{
+		Model: r.Model(),
+		Input: texts,
+	})
+}
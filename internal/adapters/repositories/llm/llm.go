@@ -0,0 +1,32 @@
+package llm
+
+import (
+	"log"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+// maxStreamLineSize bounds a single line of a streamed response
+const maxStreamLineSize = 1024 * 1024
+
+// NewLLMRepository returns the adapter of the provider selected by LLM_PROVIDER
+func NewLLMRepository(cfg *config.Config, ollamaClient *llm.OllamaClient, openAIClient *llm.OpenAIClient) repositories.LLMRepository {
+	switch cfg.LLM.Provider {
+	case config.LLMProviderOllama:
+		return NewOllamaRepository(ollamaClient, cfg)
+	case config.LLMProviderOpenAI:
+		return NewOpenAIRepository(openAIClient, cfg)
+	}
+
+	log.Fatalf("❌ Unknown LLM provider: %s\n", cfg.LLM.Provider)
+	return nil
+}
+
+func modelOrDefault(model string, defaultModel string) string {
+	if model == "" {
+		return defaultModel
+	}
+	return model
+}
@@ -1,10 +1,15 @@
 package llm
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
 )
@@ -14,61 +19,272 @@ type OllamaRepositoryImpl struct {
 	cfg    *config.Config
 }
 
-func NewOllamaRepository(client *llm.OllamaClient, cfg *config.Config) repositories.OllamaRepository {
+func NewOllamaRepository(client *llm.OllamaClient, cfg *config.Config) repositories.LLMRepository {
 	return &OllamaRepositoryImpl{
 		client: client,
 		cfg:    cfg,
 	}
 }
 
-type OllamaRequest struct {
+type OllamaGenerateRequest struct {
 	Model  string `json:"model"`
+	System string `json:"system,omitempty"`
 	Prompt string `json:"prompt"`
+	Format string `json:"format,omitempty"`
 	Stream bool   `json:"stream"`
 }
 
-type OllamaResponse struct {
-	Model              string        `json:"model"`
-	CreatedAt          string        `json:"created_at"`
-	Response           string        `json:"response"`
-	Done               bool          `json:"done"`
-	DoneReason         string        `json:"done_reason"`
-	Context            []interface{} `json:"context"`
-	TotalDuration      int64         `json:"total_duration"`
-	LoadDuration       int64         `json:"load_duration"`
-	PromptEvalCount    int64         `json:"prompt_eval_count"`
-	PromptEvalDuration int64         `json:"prompt_eval_duration"`
-	EvalCount          int64         `json:"eval_count"`
-	EvalDuration       int64         `json:"eval_duration"`
+type OllamaGenerateResponse struct {
+	Model              string `json:"model"`
+	CreatedAt          string `json:"created_at"`
+	Response           string `json:"response"`
+	Done               bool   `json:"done"`
+	DoneReason         string `json:"done_reason"`
+	TotalDuration      int64  `json:"total_duration"`
+	LoadDuration       int64  `json:"load_duration"`
+	PromptEvalCount    int64  `json:"prompt_eval_count"`
+	PromptEvalDuration int64  `json:"prompt_eval_duration"`
+	EvalCount          int64  `json:"eval_count"`
+	EvalDuration       int64  `json:"eval_duration"`
+	Error              string `json:"error"`
 }
 
-func (r *OllamaRepositoryImpl) Generate(model string, prompt string, stream bool) (string, error) {
-	request := OllamaRequest{
-		Model:  model,
-		Prompt: prompt,
-		Stream: stream,
-	}
+type OllamaChatRequest struct {
+	Model    string              `json:"model"`
+	Messages []models.LLMMessage `json:"messages"`
+	Format   string              `json:"format,omitempty"`
+	Stream   bool                `json:"stream"`
+}
+
+type OllamaChatResponse struct {
+	Model      string            `json:"model"`
+	CreatedAt  string            `json:"created_at"`
+	Message    models.LLMMessage `json:"message"`
+	Done       bool              `json:"done"`
+	DoneReason string            `json:"done_reason"`
+	Error      string            `json:"error"`
+}
+
+type OllamaEmbedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type OllamaEmbedResponse struct {
+	Model      string      `json:"model"`
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (r *OllamaRepositoryImpl) Configured() bool {
+	return r.client.BaseURL != ""
+}
+
+func (r *OllamaRepositoryImpl) Complete(ctx context.Context, in *repositories.LLMCompletionRequest) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.RequestTimeout)
+	defer cancel()
 
-	requestJson, err := json.Marshal(request)
+	response, err := r.post(ctx, "/api/generate", &OllamaGenerateRequest{
+		Model:  modelOrDefault(in.Model, r.cfg.LLM.Model.Default),
+		System: in.System,
+		Prompt: in.Prompt,
+		Format: ollamaFormat(in.JSON),
+		Stream: false,
+	})
 	if err != nil {
 		return "", err
 	}
+	defer response.Body.Close()
 
-	endpoint := "http://" + r.cfg.OllamaClient.Endpoint + "/api/generate"
-	response, err := r.client.HTTPClient.Post(
-		endpoint,
-		"application/json",
-		bytes.NewBuffer(requestJson),
-	)
-	if err != nil {
+	var ollamaResponse OllamaGenerateResponse
+	if err := json.NewDecoder(response.Body).Decode(&ollamaResponse); err != nil {
 		return "", err
 	}
 
-	var ollamaResponse OllamaResponse
-	err = json.NewDecoder(response.Body).Decode(&ollamaResponse)
+	return ollamaResponse.Response, nil
+}
+
+func (r *OllamaRepositoryImpl) CompleteStream(ctx context.Context, in *repositories.LLMCompletionRequest, onChunk repositories.LLMChunkHandler) error {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.StreamTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/api/generate", &OllamaGenerateRequest{
+		Model:  modelOrDefault(in.Model, r.cfg.LLM.Model.Default),
+		System: in.System,
+		Prompt: in.Prompt,
+		Format: ollamaFormat(in.JSON),
+		Stream: true,
+	})
 	if err != nil {
-		return "", err
+		return err
 	}
+	defer response.Body.Close()
 
-	return ollamaResponse.Response, nil
+	return readNDJSON(response, func(chunk *OllamaGenerateResponse) (bool, error) {
+		if chunk.Error != "" {
+			return true, fmt.Errorf("ollama: %s", chunk.Error)
+		}
+		if chunk.Response != "" {
+			if err := onChunk(chunk.Response); err != nil {
+				return true, err
+			}
+		}
+		return chunk.Done, nil
+	})
+}
+
+func (r *OllamaRepositoryImpl) Chat(ctx context.Context, in *repositories.LLMChatRequest) (*models.LLMMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.RequestTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/api/chat", &OllamaChatRequest{
+		Model:    modelOrDefault(in.Model, r.cfg.LLM.Model.Default),
+		Messages: in.Messages,
+		Format:   ollamaFormat(in.JSON),
+		Stream:   false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var ollamaResponse OllamaChatResponse
+	if err := json.NewDecoder(response.Body).Decode(&ollamaResponse); err != nil {
+		return nil, err
+	}
+
+	return &ollamaResponse.Message, nil
+}
+
+func (r *OllamaRepositoryImpl) ChatStream(ctx context.Context, in *repositories.LLMChatRequest, onChunk repositories.LLMChunkHandler) error {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.StreamTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/api/chat", &OllamaChatRequest{
+		Model:    modelOrDefault(in.Model, r.cfg.LLM.Model.Default),
+		Messages: in.Messages,
+		Format:   ollamaFormat(in.JSON),
+		Stream:   true,
+	})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return readNDJSON(response, func(chunk *OllamaChatResponse) (bool, error) {
+		if chunk.Error != "" {
+			return true, fmt.Errorf("ollama: %s", chunk.Error)
+		}
+		if chunk.Message.Content != "" {
+			if err := onChunk(chunk.Message.Content); err != nil {
+				return true, err
+			}
+		}
+		return chunk.Done, nil
+	})
+}
+
+func (r *OllamaRepositoryImpl) Embed(ctx context.Context, in *repositories.LLMEmbedRequest) ([][]float32, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.RequestTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/api/embed", &OllamaEmbedRequest{
+		Model: modelOrDefault(in.Model, r.cfg.LLM.Model.Embedding),
+		Input: in.Input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var ollamaResponse OllamaEmbedResponse
+	if err := json.NewDecoder(response.Body).Decode(&ollamaResponse); err != nil {
+		return nil, err
+	}
+
+	if len(ollamaResponse.Embeddings) != len(in.Input) {
+		return nil, fmt.Errorf("ollama returned %d embeddings for %d inputs", len(ollamaResponse.Embeddings), len(in.Input))
+	}
+
+	return ollamaResponse.Embeddings, nil
+}
+
+func ollamaFormat(json bool) string {
+	if json {
+		return "json"
+	}
+	return ""
+}
+
+// post sends the request to Ollama, the caller must close the body of the returned response
+func (r *OllamaRepositoryImpl) post(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	if r.client.BaseURL == "" {
+		return nil, fmt.Errorf("ollama endpoint is not configured")
+	}
+
+	requestJson, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, r.client.BaseURL+path, bytes.NewBuffer(requestJson))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := r.client.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+
+		// Ollama reports failures such as an unknown model as {"error": "..."}
+		var errorResponse struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(response.Body).Decode(&errorResponse); err == nil && errorResponse.Error != "" {
+			return nil, fmt.Errorf("ollama responded with status %d: %s", response.StatusCode, errorResponse.Error)
+		}
+		return nil, fmt.Errorf("ollama responded with status %d", response.StatusCode)
+	}
+
+	return response, nil
+}
+
+// readNDJSON decodes a streamed response line by line until handle reports done or the body ends
+func readNDJSON[T any](response *http.Response, handle func(chunk *T) (bool, error)) error {
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		chunk := new(T)
+		if err := json.Unmarshal(line, chunk); err != nil {
+			return err
+		}
+
+		done, err := handle(chunk)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if err := response.Request.Context().Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("ollama stream ended before completion")
 }
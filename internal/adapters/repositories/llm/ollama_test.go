@@ -0,0 +1,248 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+func newTestLLMConfig() *config.Config {
+	return &config.Config{
+		LLM: config.LLMConfig{
+			RequestTimeout: 5 * time.Second,
+			StreamTimeout:  5 * time.Second,
+			Model: config.LLMModelConfig{
+				Default:   "default-model",
+				Embedding: "embedding-model",
+			},
+		},
+	}
+}
+
+// newStubOllamaServer serves handler as Ollama and returns a repository talking to it
+func newStubOllamaServer(t *testing.T, handler http.HandlerFunc) repositories.LLMRepository {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewOllamaRepository(&llm.OllamaClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}, newTestLLMConfig())
+}
+
+// decodeRequest decodes the request body into out and checks the path
+func decodeRequest(t *testing.T, r *http.Request, path string, out interface{}) {
+	t.Helper()
+
+	if r.URL.Path != path {
+		t.Errorf("path = %s, want %s", r.URL.Path, path)
+	}
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		t.Errorf("failed to decode request: %v", err)
+	}
+}
+
+// collectChunks returns a chunk handler and the chunks it received
+func collectChunks() (repositories.LLMChunkHandler, *[]string) {
+	chunks := []string{}
+	return func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}, &chunks
+}
+
+func TestOllamaComplete(t *testing.T) {
+	repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OllamaGenerateRequest
+		decodeRequest(t, r, "/api/generate", &req)
+		if req.Model != "default-model" || req.Format != "json" || req.Stream || req.Prompt != "hello" {
+			t.Errorf("request = %+v", req)
+		}
+
+		_, _ = w.Write([]byte(`{"response":"{\"ok\":true}","done":true}`))
+	})
+
+	output, err := repo.Complete(context.Background(), &repositories.LLMCompletionRequest{Prompt: "hello", JSON: true})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if output != `{"ok":true}` {
+		t.Fatalf("Complete() = %s", output)
+	}
+}
+
+func TestOllamaCompleteStream(t *testing.T) {
+	repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OllamaGenerateRequest
+		decodeRequest(t, r, "/api/generate", &req)
+		if !req.Stream || req.Model != "custom-model" {
+			t.Errorf("request = %+v", req)
+		}
+
+		_, _ = w.Write([]byte(strings.Join([]string{
+			`{"response":"Hel","done":false}`,
+			``,
+			`{"response":"lo","done":false}`,
+			`{"response":"","done":true,"done_reason":"stop"}`,
+			`{"response":"ignored","done":false}`,
+		}, "\n")))
+	})
+
+	onChunk, chunks := collectChunks()
+	err := repo.CompleteStream(context.Background(), &repositories.LLMCompletionRequest{Model: "custom-model", Prompt: "hello"}, onChunk)
+	if err != nil {
+		t.Fatalf("CompleteStream() error = %v", err)
+	}
+	if want := []string{"Hel", "lo"}; !reflect.DeepEqual(*chunks, want) {
+		t.Fatalf("chunks = %v, want %v", *chunks, want)
+	}
+}
+
+func TestOllamaChatStream(t *testing.T) {
+	repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OllamaChatRequest
+		decodeRequest(t, r, "/api/chat", &req)
+		if !req.Stream || len(req.Messages) != 1 {
+			t.Errorf("request = %+v", req)
+		}
+
+		_, _ = w.Write([]byte(strings.Join([]string{
+			`{"message":{"role":"assistant","content":"Hi"},"done":false}`,
+			`{"message":{"role":"assistant","content":" there"},"done":false}`,
+			`{"message":{"role":"assistant","content":""},"done":true}`,
+		}, "\n")))
+	})
+
+	onChunk, chunks := collectChunks()
+	err := repo.ChatStream(context.Background(), &repositories.LLMChatRequest{
+		Messages: []models.LLMMessage{{Role: models.LLMMessageRoleUser, Content: "hello"}},
+	}, onChunk)
+	if err != nil {
+		t.Fatalf("ChatStream() error = %v", err)
+	}
+	if want := []string{"Hi", " there"}; !reflect.DeepEqual(*chunks, want) {
+		t.Fatalf("chunks = %v, want %v", *chunks, want)
+	}
+}
+
+func TestOllamaStreamErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "error chunk",
+			body:    `{"response":"Hel","done":false}` + "\n" + `{"error":"model crashed"}`,
+			wantErr: "ollama: model crashed",
+		},
+		{
+			name:    "ended before done",
+			body:    `{"response":"Hel","done":false}`,
+			wantErr: "ollama stream ended before completion",
+		},
+		{
+			name:    "invalid line",
+			body:    `{"response":`,
+			wantErr: "unexpected end of JSON input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			onChunk, _ := collectChunks()
+			err := repo.CompleteStream(context.Background(), &repositories.LLMCompletionRequest{Prompt: "hello"}, onChunk)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("CompleteStream() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOllamaErrorResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "error body",
+			status:  http.StatusNotFound,
+			body:    `{"error":"model \"missing\" not found, try pulling it first"}`,
+			wantErr: `ollama responded with status 404: model "missing" not found, try pulling it first`,
+		},
+		{
+			name:    "no error body",
+			status:  http.StatusBadGateway,
+			body:    `<html>bad gateway</html>`,
+			wantErr: "ollama responded with status 502",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			_, err := repo.Chat(context.Background(), &repositories.LLMChatRequest{Model: "missing"})
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Chat() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOllamaEmbed(t *testing.T) {
+	repo := newStubOllamaServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OllamaEmbedRequest
+		decodeRequest(t, r, "/api/embed", &req)
+		if req.Model != "embedding-model" {
+			t.Errorf("model = %s, want embedding-model", req.Model)
+		}
+
+		_, _ = w.Write([]byte(`{"model":"embedding-model","embeddings":[[0.1,0.2],[0.3,0.4]]}`))
+	})
+
+	embeddings, err := repo.Embed(context.Background(), &repositories.LLMEmbedRequest{Input: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if want := [][]float32{{0.1, 0.2}, {0.3, 0.4}}; !reflect.DeepEqual(embeddings, want) {
+		t.Fatalf("Embed() = %v, want %v", embeddings, want)
+	}
+
+	_, err = repo.Embed(context.Background(), &repositories.LLMEmbedRequest{Input: []string{"a", "b", "c"}})
+	if want := "ollama returned 2 embeddings for 3 inputs"; err == nil || err.Error() != want {
+		t.Fatalf("Embed() error = %v, want %s", err, want)
+	}
+}
+
+func TestOllamaNotConfigured(t *testing.T) {
+	repo := NewOllamaRepository(&llm.OllamaClient{HTTPClient: http.DefaultClient}, newTestLLMConfig())
+
+	if repo.Configured() {
+		t.Fatal("Configured() = true without an endpoint")
+	}
+	if _, err := repo.Complete(context.Background(), &repositories.LLMCompletionRequest{Prompt: "hello"}); err == nil {
+		t.Fatal("Complete() succeeded without an endpoint")
+	}
+}
@@ -0,0 +1,260 @@
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+// openAIStreamDone is sent as the last data line of a streamed chat completion
+const openAIStreamDone = "[DONE]"
+
+type OpenAIRepositoryImpl struct {
+	client *llm.OpenAIClient
+	cfg    *config.Config
+}
+
+func NewOpenAIRepository(client *llm.OpenAIClient, cfg *config.Config) repositories.LLMRepository {
+	return &OpenAIRepositoryImpl{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+type OpenAIChatCompletionRequest struct {
+	Model          string                `json:"model"`
+	Messages       []models.LLMMessage   `json:"messages"`
+	ResponseFormat *OpenAIResponseFormat `json:"response_format,omitempty"`
+	Stream         bool                  `json:"stream"`
+}
+
+type OpenAIResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type OpenAIChatCompletionResponse struct {
+	ID      string `json:"id"`
+	Model   string `json:"model"`
+	Choices []struct {
+		Index        int               `json:"index"`
+		Message      models.LLMMessage `json:"message"`
+		Delta        models.LLMMessage `json:"delta"`
+		FinishReason *string           `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+type OpenAIEmbeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type OpenAIEmbeddingResponse struct {
+	Model string `json:"model"`
+	Data  []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+type OpenAIErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error"`
+}
+
+func (r *OpenAIRepositoryImpl) Configured() bool {
+	return r.client.BaseURL != ""
+}
+
+// Complete is sent as a chat completion, the legacy completions endpoint is not supported by most servers
+func (r *OpenAIRepositoryImpl) Complete(ctx context.Context, in *repositories.LLMCompletionRequest) (string, error) {
+	message, err := r.Chat(ctx, completionToChat(in))
+	if err != nil {
+		return "", err
+	}
+
+	return message.Content, nil
+}
+
+func (r *OpenAIRepositoryImpl) CompleteStream(ctx context.Context, in *repositories.LLMCompletionRequest, onChunk repositories.LLMChunkHandler) error {
+	return r.ChatStream(ctx, completionToChat(in), onChunk)
+}
+
+func (r *OpenAIRepositoryImpl) Chat(ctx context.Context, in *repositories.LLMChatRequest) (*models.LLMMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.RequestTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/chat/completions", newOpenAIChatCompletionRequest(in, r.cfg.LLM.Model.Default, false))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var completion OpenAIChatCompletionResponse
+	if err := json.NewDecoder(response.Body).Decode(&completion); err != nil {
+		return nil, err
+	}
+
+	if len(completion.Choices) == 0 {
+		return nil, fmt.Errorf("openai returned no choices")
+	}
+
+	return &completion.Choices[0].Message, nil
+}
+
+func (r *OpenAIRepositoryImpl) ChatStream(ctx context.Context, in *repositories.LLMChatRequest, onChunk repositories.LLMChunkHandler) error {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.StreamTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/chat/completions", newOpenAIChatCompletionRequest(in, r.cfg.LLM.Model.Default, true))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	scanner := bufio.NewScanner(response.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+
+	// The stream is Server-Sent Events, only the data lines carry chunks
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+
+		data = strings.TrimSpace(data)
+		if data == openAIStreamDone {
+			return nil
+		}
+
+		var chunk OpenAIChatCompletionResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return err
+		}
+
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content == "" {
+				continue
+			}
+			if err := onChunk(choice.Delta.Content); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("openai stream ended before completion")
+}
+
+func (r *OpenAIRepositoryImpl) Embed(ctx context.Context, in *repositories.LLMEmbedRequest) ([][]float32, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.LLM.RequestTimeout)
+	defer cancel()
+
+	response, err := r.post(ctx, "/embeddings", &OpenAIEmbeddingRequest{
+		Model: modelOrDefault(in.Model, r.cfg.LLM.Model.Embedding),
+		Input: in.Input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var embeddingResponse OpenAIEmbeddingResponse
+	if err := json.NewDecoder(response.Body).Decode(&embeddingResponse); err != nil {
+		return nil, err
+	}
+
+	if len(embeddingResponse.Data) != len(in.Input) {
+		return nil, fmt.Errorf("openai returned %d embeddings for %d inputs", len(embeddingResponse.Data), len(in.Input))
+	}
+
+	sort.Slice(embeddingResponse.Data, func(i, j int) bool {
+		return embeddingResponse.Data[i].Index < embeddingResponse.Data[j].Index
+	})
+
+	embeddings := make([][]float32, 0, len(embeddingResponse.Data))
+	for _, data := range embeddingResponse.Data {
+		embeddings = append(embeddings, data.Embedding)
+	}
+
+	return embeddings, nil
+}
+
+// post sends the request to the API, the caller must close the body of the returned response
+func (r *OpenAIRepositoryImpl) post(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	requestJson, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, r.client.BaseURL+path, bytes.NewBuffer(requestJson))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if r.client.APIKey != "" {
+		request.Header.Set("Authorization", "Bearer "+r.client.APIKey)
+	}
+
+	response, err := r.client.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+
+		var errorResponse OpenAIErrorResponse
+		if err := json.NewDecoder(response.Body).Decode(&errorResponse); err == nil && errorResponse.Error.Message != "" {
+			return nil, fmt.Errorf("openai responded with status %d: %s", response.StatusCode, errorResponse.Error.Message)
+		}
+		return nil, fmt.Errorf("openai responded with status %d", response.StatusCode)
+	}
+
+	return response, nil
+}
+
+func newOpenAIChatCompletionRequest(in *repositories.LLMChatRequest, defaultModel string, stream bool) *OpenAIChatCompletionRequest {
+	request := &OpenAIChatCompletionRequest{
+		Model:    modelOrDefault(in.Model, defaultModel),
+		Messages: in.Messages,
+		Stream:   stream,
+	}
+	if in.JSON {
+		request.ResponseFormat = &OpenAIResponseFormat{Type: "json_object"}
+	}
+	return request
+}
+
+func completionToChat(in *repositories.LLMCompletionRequest) *repositories.LLMChatRequest {
+	messages := make([]models.LLMMessage, 0, 2)
+	if in.System != "" {
+		messages = append(messages, models.LLMMessage{Role: models.LLMMessageRoleSystem, Content: in.System})
+	}
+	messages = append(messages, models.LLMMessage{Role: models.LLMMessageRoleUser, Content: in.Prompt})
+
+	return &repositories.LLMChatRequest{
+		Model:    in.Model,
+		Messages: messages,
+		JSON:     in.JSON,
+	}
+}
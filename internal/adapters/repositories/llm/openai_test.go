@@ -0,0 +1,185 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
+)
+
+// newStubOpenAIServer serves handler as an OpenAI compatible API and returns a repository talking to it
+func newStubOpenAIServer(t *testing.T, handler http.HandlerFunc) repositories.LLMRepository {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewOpenAIRepository(&llm.OpenAIClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+		APIKey:     "test-key",
+	}, newTestLLMConfig())
+}
+
+func TestOpenAIComplete(t *testing.T) {
+	repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-key" {
+			t.Errorf("Authorization = %s", got)
+		}
+
+		var req OpenAIChatCompletionRequest
+		decodeRequest(t, r, "/chat/completions", &req)
+		wantMessages := []models.LLMMessage{
+			{Role: models.LLMMessageRoleSystem, Content: "be brief"},
+			{Role: models.LLMMessageRoleUser, Content: "hello"},
+		}
+		if req.Model != "default-model" || req.Stream || !reflect.DeepEqual(req.Messages, wantMessages) {
+			t.Errorf("request = %+v", req)
+		}
+		if req.ResponseFormat == nil || req.ResponseFormat.Type != "json_object" {
+			t.Errorf("response_format = %+v, want json_object", req.ResponseFormat)
+		}
+
+		_, _ = w.Write([]byte(`{"id":"1","choices":[{"index":0,"message":{"role":"assistant","content":"{}"},"finish_reason":"stop"}]}`))
+	})
+
+	output, err := repo.Complete(context.Background(), &repositories.LLMCompletionRequest{System: "be brief", Prompt: "hello", JSON: true})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if output != "{}" {
+		t.Fatalf("Complete() = %s", output)
+	}
+}
+
+func TestOpenAIChatWithoutChoices(t *testing.T) {
+	repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"1","choices":[]}`))
+	})
+
+	_, err := repo.Chat(context.Background(), &repositories.LLMChatRequest{})
+	if want := "openai returned no choices"; err == nil || err.Error() != want {
+		t.Fatalf("Chat() error = %v, want %s", err, want)
+	}
+}
+
+func TestOpenAIChatStream(t *testing.T) {
+	repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OpenAIChatCompletionRequest
+		decodeRequest(t, r, "/chat/completions", &req)
+		if !req.Stream {
+			t.Error("stream = false, want true")
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(strings.Join([]string{
+			`: keep-alive`,
+			``,
+			`data: {"choices":[{"index":0,"delta":{"role":"assistant","content":""}}]}`,
+			``,
+			`data: {"choices":[{"index":0,"delta":{"content":"Hel"}}]}`,
+			``,
+			`data:{"choices":[{"index":0,"delta":{"content":"lo"},"finish_reason":"stop"}]}`,
+			``,
+			`data: [DONE]`,
+			``,
+			`data: {"choices":[{"index":0,"delta":{"content":"ignored"}}]}`,
+			``,
+		}, "\n")))
+	})
+
+	onChunk, chunks := collectChunks()
+	err := repo.ChatStream(context.Background(), &repositories.LLMChatRequest{
+		Messages: []models.LLMMessage{{Role: models.LLMMessageRoleUser, Content: "hello"}},
+	}, onChunk)
+	if err != nil {
+		t.Fatalf("ChatStream() error = %v", err)
+	}
+	if want := []string{"Hel", "lo"}; !reflect.DeepEqual(*chunks, want) {
+		t.Fatalf("chunks = %v, want %v", *chunks, want)
+	}
+}
+
+func TestOpenAIChatStreamWithoutDone(t *testing.T) {
+	repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("data: {\"choices\":[{\"index\":0,\"delta\":{\"content\":\"Hel\"}}]}\n\n"))
+	})
+
+	onChunk, chunks := collectChunks()
+	err := repo.ChatStream(context.Background(), &repositories.LLMChatRequest{}, onChunk)
+	if want := "openai stream ended before completion"; err == nil || err.Error() != want {
+		t.Fatalf("ChatStream() error = %v, want %s", err, want)
+	}
+	if want := []string{"Hel"}; !reflect.DeepEqual(*chunks, want) {
+		t.Fatalf("chunks = %v, want %v", *chunks, want)
+	}
+}
+
+func TestOpenAIErrorResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:    "error body",
+			status:  http.StatusUnauthorized,
+			body:    `{"error":{"message":"Incorrect API key provided","type":"invalid_request_error"}}`,
+			wantErr: "openai responded with status 401: Incorrect API key provided",
+		},
+		{
+			name:    "no error body",
+			status:  http.StatusServiceUnavailable,
+			body:    `upstream unavailable`,
+			wantErr: "openai responded with status 503",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			onChunk, _ := collectChunks()
+			err := repo.ChatStream(context.Background(), &repositories.LLMChatRequest{}, onChunk)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("ChatStream() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOpenAIEmbed(t *testing.T) {
+	repo := newStubOpenAIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req OpenAIEmbeddingRequest
+		decodeRequest(t, r, "/embeddings", &req)
+		if req.Model != "embedding-model" {
+			t.Errorf("model = %s, want embedding-model", req.Model)
+		}
+
+		// The data is not required to be in input order, the index says which input it belongs to
+		_, _ = w.Write([]byte(`{"model":"embedding-model","data":[{"index":1,"embedding":[0.3,0.4]},{"index":0,"embedding":[0.1,0.2]}]}`))
+	})
+
+	embeddings, err := repo.Embed(context.Background(), &repositories.LLMEmbedRequest{Input: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if want := [][]float32{{0.1, 0.2}, {0.3, 0.4}}; !reflect.DeepEqual(embeddings, want) {
+		t.Fatalf("Embed() = %v, want %v", embeddings, want)
+	}
+
+	_, err = repo.Embed(context.Background(), &repositories.LLMEmbedRequest{Input: []string{"a"}})
+	if want := "openai returned 2 embeddings for 1 inputs"; err == nil || err.Error() != want {
+		t.Fatalf("Embed() error = %v, want %s", err, want)
+	}
+}
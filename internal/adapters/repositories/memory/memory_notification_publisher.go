@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// subscriberBufferSize bounds how many notifications can wait for a slow subscriber before new ones are dropped
+const subscriberBufferSize = 16
+
+type memoryNotificationPublisher struct {
+	mu          sync.RWMutex
+	subscribers map[bson.ObjectID]map[chan *models.Notification]struct{}
+}
+
+// NewMemoryNotificationPublisher fans notifications out to subscribers within this process only
+func NewMemoryNotificationPublisher() repositories.NotificationPublisher {
+	return &memoryNotificationPublisher{
+		subscribers: make(map[bson.ObjectID]map[chan *models.Notification]struct{}),
+	}
+}
+
+func (m *memoryNotificationPublisher) Publish(ctx context.Context, notification *models.Notification) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for ch := range m.subscribers[notification.UserID] {
+		select {
+		case ch <- notification:
+		default:
+			// The notification is still stored, the subscriber will see it on the next list call
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryNotificationPublisher) Subscribe(userID bson.ObjectID) (<-chan *models.Notification, func()) {
+	ch := make(chan *models.Notification, subscriberBufferSize)
+
+	m.mu.Lock()
+	if _, ok := m.subscribers[userID]; !ok {
+		m.subscribers[userID] = make(map[chan *models.Notification]struct{})
+	}
+	m.subscribers[userID][ch] = struct{}{}
+	m.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+
+			delete(m.subscribers[userID], ch)
+			if len(m.subscribers[userID]) == 0 {
+				delete(m.subscribers, userID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
@@ -0,0 +1,28 @@
+package mongo
+
+import (
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type activityFilter bson.M
+
+func NewActivityFilter() activityFilter {
+	return activityFilter{}
+}
+
+func (f activityFilter) WithProjectID(projectID bson.ObjectID) {
+	f["project_id"] = projectID
+}
+
+func (f activityFilter) WithTaskID(taskID string) {
+	f["task_id"] = taskID
+}
+
+func (f activityFilter) WithEntityType(entityType models.ActivityEntityType) {
+	f["entity_type"] = entityType
+}
+
+func (f activityFilter) WithEntityID(entityID string) {
+	f["entity_id"] = entityID
+}
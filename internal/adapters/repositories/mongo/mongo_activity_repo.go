@@ -0,0 +1,119 @@
+package mongo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoActivityRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoActivityRepo(config *config.Config, mongoClient *mongo.Client) repositories.ActivityRepository {
+	return &mongoActivityRepo{
+		collection: mongoClient.Database(config.MongoDB.Database).Collection("activities"),
+	}
+}
+
+func (m *mongoActivityRepo) Create(ctx context.Context, in *repositories.CreateActivityRequest) error {
+	_, err := m.collection.InsertOne(ctx, newActivity(in, time.Now()))
+	return err
+}
+
+func (m *mongoActivityRepo) CreateMany(ctx context.Context, in []*repositories.CreateActivityRequest) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	activities := make([]interface{}, 0, len(in))
+	for _, activity := range in {
+		activities = append(activities, newActivity(activity, now))
+	}
+
+	_, err := m.collection.InsertMany(ctx, activities)
+	return err
+}
+
+func (m *mongoActivityRepo) RedactChanges(ctx context.Context, in *repositories.RedactActivityChangesRequest) error {
+	f := NewActivityFilter()
+	f.WithEntityType(in.EntityType)
+	f.WithEntityID(in.EntityID)
+
+	u := bson.M{
+		"$set": bson.M{
+			"changes.$[change].old_value": nil,
+			"changes.$[change].new_value": nil,
+		},
+	}
+
+	updateOptions := options.UpdateMany().SetArrayFilters([]interface{}{
+		bson.M{"change.field": in.Field},
+	})
+
+	_, err := m.collection.UpdateMany(ctx, f, u, updateOptions)
+	return err
+}
+
+func (m *mongoActivityRepo) Search(ctx context.Context, in *repositories.SearchActivityRequest) ([]*models.Activity, int64, error) {
+	f := NewActivityFilter()
+	f.WithProjectID(in.ProjectID)
+
+	if in.TaskID != nil {
+		f.WithTaskID(*in.TaskID)
+	}
+	if in.EntityType != "" {
+		f.WithEntityType(in.EntityType)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSkip(int64((in.PaginationRequest.Page - 1) * in.PaginationRequest.PageSize))
+	findOptions.SetLimit(int64(in.PaginationRequest.PageSize))
+
+	sortOrder := 1
+	if strings.ToUpper(in.PaginationRequest.Order) == constant.DESC {
+		sortOrder = -1
+	}
+	findOptions.SetSort(bson.D{{Key: in.PaginationRequest.SortBy, Value: sortOrder}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	activities := []*models.Activity{}
+	if err := cursor.All(ctx, &activities); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return activities, total, nil
+}
+
+func newActivity(in *repositories.CreateActivityRequest, createdAt time.Time) *models.Activity {
+	return &models.Activity{
+		ID:         bson.NewObjectID(),
+		ProjectID:  in.ProjectID,
+		TaskID:     in.TaskID,
+		EntityType: in.EntityType,
+		EntityID:   in.EntityID,
+		Action:     in.Action,
+		Changes:    in.Changes,
+		CreatedAt:  createdAt,
+		CreatedBy:  in.CreatedBy,
+	}
+}
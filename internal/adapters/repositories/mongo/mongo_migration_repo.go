@@ -0,0 +1,266 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/array"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// legacyTaskIDIndex made task IDs unique across workspaces, but project prefixes are only unique per workspace
+const legacyTaskIDIndex = "task_id_1"
+
+const activeSprintIndex = "project_id_active_sprint"
+
+type mongoMigrationRepo struct {
+	database *mongo.Database
+}
+
+func NewMongoMigrationRepo(config *config.Config, mongoClient *mongo.Client) repositories.MigrationRepository {
+	return &mongoMigrationRepo{
+		database: mongoClient.Database(config.MongoDB.Database),
+	}
+}
+
+func (m *mongoMigrationRepo) Migrate(ctx context.Context) error {
+	if err := m.dropIndexIfExists(ctx, m.database.Collection("tasks"), legacyTaskIDIndex); err != nil {
+		return fmt.Errorf("failed to drop legacy tasks index: %w", err)
+	}
+
+	if err := m.dedupeTaskIDs(ctx); err != nil {
+		return fmt.Errorf("failed to dedupe task IDs: %w", err)
+	}
+
+	// Task IDs like "ABC-12" must never be issued twice within a project
+	_, err := m.database.Collection("tasks").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "project_id", Value: 1}, {Key: "task_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create tasks index: %w", err)
+	}
+
+	// Task IDs are looked up without a project, so a prefix must not be reused by another workspace
+	_, err = m.database.Collection("projects").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "project_prefix", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// Tasks of these projects can not be found by task ID until one of the projects is given another prefix
+		log.Printf("⚠️ Some workspaces share a project prefix, give the projects distinct prefixes to enforce unique prefixes: %v\n", err)
+	} else if err != nil {
+		return fmt.Errorf("failed to create projects index: %w", err)
+	}
+
+	if err := m.redactDeletedComments(ctx); err != nil {
+		return fmt.Errorf("failed to redact deleted comments: %w", err)
+	}
+
+	if err := m.backfillDoneStatuses(ctx); err != nil {
+		return fmt.Errorf("failed to backfill done statuses: %w", err)
+	}
+
+	// A project has at most one active sprint, starting a second one must fail even when two starts race
+	_, err = m.database.Collection("sprints").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "project_id", Value: 1}},
+		Options: options.Index().
+			SetName(activeSprintIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": models.SprintStatusActive}),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// Starting a sprint still checks for an active one, the index is created once the extra sprints are completed
+		log.Printf("⚠️ Some projects have more than one active sprint, complete the extra sprints to enforce a single active sprint: %v\n", err)
+	} else if err != nil {
+		return fmt.Errorf("failed to create sprints index: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoMigrationRepo) dropIndexIfExists(ctx context.Context, collection *mongo.Collection, name string) error {
+	specifications, err := collection.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, specification := range specifications {
+		if specification.Name == name {
+			return collection.Indexes().DropOne(ctx, name)
+		}
+	}
+
+	return nil
+}
+
+// dedupeTaskIDs gives a new task ID to every task that shares its ID with an older task of the same project.
+// Duplicates were possible before task running numbers were reserved atomically.
+func (m *mongoMigrationRepo) dedupeTaskIDs(ctx context.Context) error {
+	tasks := m.database.Collection("tasks")
+	projects := m.database.Collection("projects")
+
+	cursor, err := tasks.Aggregate(ctx, []bson.M{
+		{"$sort": bson.M{"created_at": 1}},
+		{"$group": bson.M{
+			"_id":   bson.M{"project_id": "$project_id", "task_id": "$task_id"},
+			"ids":   bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}},
+		{"$match": bson.M{"count": bson.M{"$gt": 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var duplicates []struct {
+		Key struct {
+			ProjectID bson.ObjectID `bson:"project_id"`
+			TaskID    string        `bson:"task_id"`
+		} `bson:"_id"`
+		IDs []bson.ObjectID `bson:"ids"`
+	}
+	if err := cursor.All(ctx, &duplicates); err != nil {
+		return err
+	}
+
+	for _, duplicate := range duplicates {
+		// The oldest task keeps its ID, links and comments already point to it
+		for _, id := range duplicate.IDs[1:] {
+			newTaskID, err := m.nextFreeTaskID(ctx, projects, tasks, duplicate.Key.ProjectID)
+			if err != nil {
+				return err
+			}
+
+			if _, err := tasks.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"task_id": newTaskID}}); err != nil {
+				return err
+			}
+
+			log.Printf("🔧 Renamed duplicated task %s (%s) to %s\n", duplicate.Key.TaskID, id.Hex(), newTaskID)
+		}
+	}
+
+	return nil
+}
+
+// nextFreeTaskID reserves task running numbers of the project until one is not used by a task yet.
+// task_running_number holds the next number to issue, so the number reserved is the one before the $inc.
+func (m *mongoMigrationRepo) nextFreeTaskID(ctx context.Context, projects *mongo.Collection, tasks *mongo.Collection, projectID bson.ObjectID) (string, error) {
+	for {
+		var project struct {
+			ProjectPrefix     string `bson:"project_prefix"`
+			TaskRunningNumber int    `bson:"task_running_number"`
+		}
+		err := projects.FindOneAndUpdate(ctx,
+			bson.M{"_id": projectID},
+			bson.M{"$inc": bson.M{"task_running_number": 1}},
+			options.FindOneAndUpdate().SetReturnDocument(options.Before),
+		).Decode(&project)
+		if err != nil {
+			return "", err
+		}
+
+		taskID := fmt.Sprintf("%s-%d", project.ProjectPrefix, project.TaskRunningNumber)
+		count, err := tasks.CountDocuments(ctx, bson.M{"project_id": projectID, "task_id": taskID})
+		if err != nil {
+			return "", err
+		} else if count == 0 {
+			return taskID, nil
+		}
+	}
+}
+
+// backfillDoneStatuses marks the done statuses of workflows created before IsDone existed.
+// They are guessed once from the transitions, afterwards they are only changed by editing the workflow.
+func (m *mongoMigrationRepo) backfillDoneStatuses(ctx context.Context) error {
+	projects := m.database.Collection("projects")
+
+	cursor, err := projects.Find(ctx, bson.M{
+		"workflows.0": bson.M{"$exists": true},
+		"workflows":   bson.M{"$not": bson.M{"$elemMatch": bson.M{"is_done": bson.M{"$exists": true}}}},
+	})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var legacyProjects []struct {
+		ID        bson.ObjectID     `bson:"_id"`
+		Workflows []models.Workflow `bson:"workflows"`
+	}
+	if err := cursor.All(ctx, &legacyProjects); err != nil {
+		return err
+	}
+
+	for _, project := range legacyProjects {
+		terminalStatuses := models.GetTerminalStatuses(project.Workflows)
+
+		bsonWorkflows := make([]bson.M, len(project.Workflows))
+		for i, w := range project.Workflows {
+			if w.PreviousStatuses == nil {
+				w.PreviousStatuses = []string{}
+			}
+			bsonWorkflows[i] = bson.M{
+				"previous_statuses": w.PreviousStatuses,
+				"status":            w.Status,
+				"is_default":        w.IsDefault,
+				"is_done":           array.ContainAny(terminalStatuses, []string{w.Status}),
+				"rules":             w.Rules,
+			}
+		}
+
+		if _, err := projects.UpdateOne(ctx, bson.M{"_id": project.ID}, bson.M{"$set": bson.M{"workflows": bsonWorkflows}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// redactDeletedComments clears the content kept in the activities of comments deleted before deletion redacted them
+func (m *mongoMigrationRepo) redactDeletedComments(ctx context.Context) error {
+	cursor, err := m.database.Collection("task_comments").Find(ctx,
+		bson.M{"deleted_at": bson.M{"$ne": nil}},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var deletedComments []struct {
+		ID bson.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &deletedComments); err != nil {
+		return err
+	}
+	if len(deletedComments) == 0 {
+		return nil
+	}
+
+	entityIDs := make([]string, 0, len(deletedComments))
+	for _, comment := range deletedComments {
+		entityIDs = append(entityIDs, comment.ID.Hex())
+	}
+
+	_, err = m.database.Collection("activities").UpdateMany(ctx,
+		bson.M{
+			"entity_type": models.ActivityEntityTypeTaskComment,
+			"entity_id":   bson.M{"$in": entityIDs},
+			"changes":     bson.M{"$elemMatch": bson.M{"field": "content", "$or": bson.A{bson.M{"old_value": bson.M{"$ne": nil}}, bson.M{"new_value": bson.M{"$ne": nil}}}}},
+		},
+		bson.M{"$set": bson.M{
+			"changes.$[change].old_value": nil,
+			"changes.$[change].new_value": nil,
+		}},
+		options.UpdateMany().SetArrayFilters([]interface{}{bson.M{"change.field": "content"}}),
+	)
+	return err
+}
@@ -0,0 +1,89 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// newTestMongo connects to MONGO_TEST_URI and returns a config for a database dropped after the test
+func newTestMongo(t *testing.T) (*config.Config, *mongo.Client) {
+	t.Helper()
+
+	uri := os.Getenv("MONGO_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_TEST_URI is not set")
+	}
+
+	client, err := mongo.Connect(options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("mongo.Connect() error = %v", err)
+	}
+
+	cfg := &config.Config{MongoDB: config.MongoDBConfig{URI: uri, Database: fmt.Sprintf("task_nexus_test_%s", bson.NewObjectID().Hex())}}
+	t.Cleanup(func() {
+		_ = client.Database(cfg.MongoDB.Database).Drop(context.Background())
+		_ = client.Disconnect(context.Background())
+	})
+
+	return cfg, client
+}
+
+func TestMigrateDedupesTaskIDsBelowTheNextRunningNumber(t *testing.T) {
+	ctx := context.Background()
+	cfg, client := newTestMongo(t)
+	database := client.Database(cfg.MongoDB.Database)
+
+	// task_running_number is the next number to issue, DUP-1 and DUP-2 were issued and DUP-2 twice
+	projectID := bson.NewObjectID()
+	if _, err := database.Collection("projects").InsertOne(ctx, bson.M{"_id": projectID, "project_prefix": "DUP", "task_running_number": 3}); err != nil {
+		t.Fatalf("failed to insert project: %v", err)
+	}
+	createdAt := time.Now()
+	duplicateID := bson.NewObjectID()
+	_, err := database.Collection("tasks").InsertMany(ctx, []interface{}{
+		bson.M{"_id": bson.NewObjectID(), "project_id": projectID, "task_id": "DUP-1", "created_at": createdAt},
+		bson.M{"_id": bson.NewObjectID(), "project_id": projectID, "task_id": "DUP-2", "created_at": createdAt.Add(time.Second)},
+		bson.M{"_id": duplicateID, "project_id": projectID, "task_id": "DUP-2", "created_at": createdAt.Add(2 * time.Second)},
+	})
+	if err != nil {
+		t.Fatalf("failed to insert tasks: %v", err)
+	}
+
+	if err := NewMongoMigrationRepo(cfg, client).Migrate(ctx); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+
+	var renamed struct {
+		TaskID string `bson:"task_id"`
+	}
+	if err := database.Collection("tasks").FindOne(ctx, bson.M{"_id": duplicateID}).Decode(&renamed); err != nil {
+		t.Fatalf("failed to find renamed task: %v", err)
+	}
+	if renamed.TaskID != "DUP-3" {
+		t.Fatalf("renamed task ID = %s, want DUP-3", renamed.TaskID)
+	}
+
+	// The next task of the project must not collide with the renamed duplicate
+	runningNumber, err := NewMongoProjectRepo(cfg, client).NextTaskRunningNumber(ctx, projectID)
+	if err != nil {
+		t.Fatalf("NextTaskRunningNumber() error = %v", err)
+	}
+	_, err = NewMongoTaskRepo(cfg, client).Create(ctx, &repositories.CreateTaskRequest{
+		TaskID:    fmt.Sprintf("DUP-%d", runningNumber),
+		ProjectID: projectID,
+		Title:     "after dedupe",
+		CreatedBy: bson.NewObjectID(),
+	})
+	if err != nil {
+		t.Fatalf("Create() after dedupe error = %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type notificationFilter bson.M
+
+func NewNotificationFilter() notificationFilter {
+	return notificationFilter{}
+}
+
+func (f notificationFilter) WithID(id bson.ObjectID) {
+	f["_id"] = id
+}
+
+func (f notificationFilter) WithUserID(userID bson.ObjectID) {
+	f["user_id"] = userID
+}
+
+func (f notificationFilter) WithUnread() {
+	f["read_at"] = nil
+}
+
+type notificationUpdate bson.M
+
+func NewNotificationUpdate() notificationUpdate {
+	return notificationUpdate{}
+}
+
+func (u notificationUpdate) set(key string, value interface{}) {
+	if _, ok := u["$set"]; !ok {
+		u["$set"] = bson.M{}
+	}
+	u["$set"].(bson.M)[key] = value
+}
+
+func (u notificationUpdate) WithReadAt(readAt time.Time) {
+	u.set("read_at", readAt)
+}
@@ -0,0 +1,155 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoNotificationRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoNotificationRepo(config *config.Config, mongoClient *mongo.Client) repositories.NotificationRepository {
+	return &mongoNotificationRepo{
+		collection: mongoClient.Database(config.MongoDB.Database).Collection("notifications"),
+	}
+}
+
+func (m *mongoNotificationRepo) CreateMany(ctx context.Context, in []*repositories.CreateNotificationRequest) ([]*models.Notification, error) {
+	if len(in) == 0 {
+		return []*models.Notification{}, nil
+	}
+
+	now := time.Now()
+	notifications := make([]*models.Notification, 0, len(in))
+	documents := make([]interface{}, 0, len(in))
+	for _, notification := range in {
+		newNotification := &models.Notification{
+			ID:          bson.NewObjectID(),
+			UserID:      notification.UserID,
+			Type:        notification.Type,
+			Message:     notification.Message,
+			WorkspaceID: notification.WorkspaceID,
+			ProjectID:   notification.ProjectID,
+			TaskID:      notification.TaskID,
+			SprintID:    notification.SprintID,
+			ActorID:     notification.ActorID,
+			CreatedAt:   now,
+		}
+		notifications = append(notifications, newNotification)
+		documents = append(documents, newNotification)
+	}
+
+	_, err := m.collection.InsertMany(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (m *mongoNotificationRepo) Search(ctx context.Context, in *repositories.SearchNotificationRequest) ([]*models.Notification, int64, error) {
+	f := NewNotificationFilter()
+	f.WithUserID(in.UserID)
+
+	if in.IsUnread {
+		f.WithUnread()
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSkip(int64((in.PaginationRequest.Page - 1) * in.PaginationRequest.PageSize))
+	findOptions.SetLimit(int64(in.PaginationRequest.PageSize))
+
+	sortOrder := 1
+	if strings.ToUpper(in.PaginationRequest.Order) == constant.DESC {
+		sortOrder = -1
+	}
+	findOptions.SetSort(bson.D{{Key: in.PaginationRequest.SortBy, Value: sortOrder}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	notifications := []*models.Notification{}
+	if err := cursor.All(ctx, &notifications); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+func (m *mongoNotificationRepo) CountUnread(ctx context.Context, userID bson.ObjectID) (int64, error) {
+	f := NewNotificationFilter()
+	f.WithUserID(userID)
+	f.WithUnread()
+
+	return m.collection.CountDocuments(ctx, f)
+}
+
+func (m *mongoNotificationRepo) MarkAsRead(ctx context.Context, id bson.ObjectID, userID bson.ObjectID) (*models.Notification, error) {
+	f := NewNotificationFilter()
+	f.WithID(id)
+	f.WithUserID(userID)
+
+	notification := new(models.Notification)
+	err := m.collection.FindOne(ctx, f).Decode(notification)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Keep the time a notification was first read
+	if notification.ReadAt != nil {
+		return notification, nil
+	}
+
+	u := NewNotificationUpdate()
+	u.WithReadAt(time.Now())
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err = m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(notification)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func (m *mongoNotificationRepo) MarkAllAsRead(ctx context.Context, userID bson.ObjectID) (int64, error) {
+	f := NewNotificationFilter()
+	f.WithUserID(userID)
+	f.WithUnread()
+
+	u := NewNotificationUpdate()
+	u.WithReadAt(time.Now())
+
+	result, err := m.collection.UpdateMany(ctx, f, u)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
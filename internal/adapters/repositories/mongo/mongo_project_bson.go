@@ -1,6 +1,10 @@
 package mongo
 
-import "go.mongodb.org/mongo-driver/v2/bson"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type projectFilter bson.M
 
@@ -26,6 +30,15 @@ func (f projectFilter) WithName(name string) {
 	f["name"] = name
 }
 
+// WithUpdatedAt matches a project that was not updated since updatedAt, a zero time matches a project that never was
+func (f projectFilter) WithUpdatedAt(updatedAt time.Time) {
+	if updatedAt.IsZero() {
+		f["updated_at"] = nil
+		return
+	}
+	f["updated_at"] = updatedAt
+}
+
 func (f projectFilter) WithProjectPrefix(projectPrefix string) {
 	f["project_prefix"] = projectPrefix
 }
@@ -60,12 +73,20 @@ func (u projectUpdate) AddMembers(member []bson.M) {
 	}
 }
 
-func (u projectUpdate) AddWorkflows(workflow []bson.M) {
-	u["$push"] = bson.M{
-		"workflows": bson.M{
-			"$each": workflow,
-		},
+func (u projectUpdate) set(key string, value interface{}) {
+	if _, ok := u["$set"]; !ok {
+		u["$set"] = bson.M{}
 	}
+	u["$set"].(bson.M)[key] = value
+}
+
+func (u projectUpdate) WithWorkflows(workflows []bson.M) {
+	u.set("workflows", workflows)
+}
+
+func (u projectUpdate) WithUpdatedBy(updatedBy bson.ObjectID) {
+	u.set("updated_by", updatedBy)
+	u.set("updated_at", time.Now())
 }
 
 func (u projectUpdate) IncrementSprintRunningNumber() {
@@ -12,6 +12,7 @@ import (
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type mongoProjectRepo struct {
@@ -63,11 +64,10 @@ func (m *mongoProjectRepo) FindByWorkspaceIDAndName(ctx context.Context, workspa
 	return project, nil
 }
 
-func (m *mongoProjectRepo) FindByWorkspaceIDAndProjectPrefix(ctx context.Context, workspaceID bson.ObjectID, projectPrefix string) (*models.Project, error) {
+func (m *mongoProjectRepo) FindByProjectPrefix(ctx context.Context, projectPrefix string) (*models.Project, error) {
 	project := new(models.Project)
 
 	f := NewProjectFilter()
-	f.WithWorkspaceID(workspaceID)
 	f.WithProjectPrefix(projectPrefix)
 
 	err := m.collection.FindOne(ctx, f).Decode(project)
@@ -82,17 +82,7 @@ func (m *mongoProjectRepo) FindByWorkspaceIDAndProjectPrefix(ctx context.Context
 }
 
 func (m *mongoProjectRepo) Create(ctx context.Context, project *repositories.CreateProjectRequest) (*models.Project, error) {
-	// session, err := m.client.StartSession()
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// defer session.EndSession(ctx)
-
-	var newProject models.Project
-	// _, err = session.WithTransaction(
-	// 	ctx,
-	// 	func(ctx context.Context) (interface{}, error) {
-	newProject = models.Project{
+	newProject := models.Project{
 		ID:                  bson.NewObjectID(),
 		WorkspaceID:         project.WorkspaceID,
 		Name:                project.Name,
@@ -111,7 +101,9 @@ func (m *mongoProjectRepo) Create(ctx context.Context, project *repositories.Cre
 	}
 
 	_, err := m.collection.InsertOne(ctx, newProject)
-	if err != nil {
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, repositories.ErrDuplicateKey
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -130,13 +122,6 @@ func (m *mongoProjectRepo) Create(ctx context.Context, project *repositories.Cre
 		return nil, err
 	}
 
-	// return nil, nil
-	// 	},
-	// )
-	// if err != nil {
-	// 	return nil, err
-	// }
-
 	return &newProject, nil
 }
 
@@ -312,79 +297,98 @@ func (m *mongoProjectRepo) SearchProjectMember(ctx context.Context, in *reposito
 	return result.Members, countResult.Count, nil
 }
 
-func (m *mongoProjectRepo) AddWorkflows(ctx context.Context, projectID bson.ObjectID, workflows []models.Workflow) error {
+func (m *mongoProjectRepo) FindWorkflowByProjectID(ctx context.Context, projectID bson.ObjectID) ([]models.Workflow, error) {
 	f := NewProjectFilter()
 	f.WithID(projectID)
 
-	update := NewProjectUpdate()
+	var result struct {
+		Workflows []models.Workflow `bson:"workflows"`
+	}
 
-	bsonWorkflows := make([]bson.M, len(workflows))
-	for i, w := range workflows {
+	err := m.collection.FindOne(ctx, f).Decode(&result)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return result.Workflows, nil
+}
+
+func (m *mongoProjectRepo) UpdateWorkflows(ctx context.Context, in *repositories.UpdateProjectWorkflowsRequest) error {
+	// Workflow edits rewrite the whole list, so an edit based on an older read would undo the edits made since
+	f := NewProjectFilter()
+	f.WithID(in.ProjectID)
+	f.WithUpdatedAt(in.UpdatedAt)
+
+	bsonWorkflows := make([]bson.M, len(in.Workflows))
+	for i, w := range in.Workflows {
 		if w.PreviousStatuses == nil {
 			w.PreviousStatuses = []string{}
 		}
 		bsonWorkflows[i] = bson.M{
 			"previous_statuses": w.PreviousStatuses,
 			"status":            w.Status,
+			"is_default":        w.IsDefault,
+			"is_done":           w.IsDone,
+			"rules":             w.Rules,
 		}
 	}
-	update.AddWorkflows(bsonWorkflows)
 
-	_, err := m.collection.UpdateOne(ctx, f, update)
+	u := NewProjectUpdate()
+	u.WithWorkflows(bsonWorkflows)
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	result, err := m.collection.UpdateOne(ctx, f, u)
 	if err != nil {
 		return err
+	} else if result.MatchedCount == 0 {
+		return repositories.ErrWriteConflict
 	}
 
 	return nil
 }
 
-func (m *mongoProjectRepo) FindWorkflowByProjectID(ctx context.Context, projectID bson.ObjectID) ([]models.Workflow, error) {
-	f := NewProjectFilter()
-	f.WithID(projectID)
-
-	var result struct {
-		Workflows []models.Workflow `bson:"workflows"`
-	}
+func (m *mongoProjectRepo) NextSprintRunningNumber(ctx context.Context, projectID bson.ObjectID) (int, error) {
+	u := NewProjectUpdate()
+	u.IncrementSprintRunningNumber()
 
-	err := m.collection.FindOne(ctx, f).Decode(&result)
+	project, err := m.incrementRunningNumber(ctx, projectID, u)
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, nil
-		}
-		return nil, err
+		return 0, err
 	}
 
-	return result.Workflows, nil
+	return project.SprintRunningNumber, nil
 }
 
-func (m *mongoProjectRepo) IncrementSprintRunningNumber(ctx context.Context, projectID bson.ObjectID) error {
-	f := NewProjectFilter()
-	f.WithID(projectID)
-
+func (m *mongoProjectRepo) NextTaskRunningNumber(ctx context.Context, projectID bson.ObjectID) (int, error) {
 	u := NewProjectUpdate()
-	u.IncrementSprintRunningNumber()
+	u.IncrementTaskRunningNumber()
 
-	_, err := m.collection.UpdateOne(ctx, f, u)
+	project, err := m.incrementRunningNumber(ctx, projectID, u)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return project.TaskRunningNumber, nil
 }
 
-func (m *mongoProjectRepo) IncrementTaskRunningNumber(ctx context.Context, projectID bson.ObjectID) error {
+// incrementRunningNumber applies the $inc and returns the project as it was before the update,
+// so the running number in the result is the one reserved for the caller
+func (m *mongoProjectRepo) incrementRunningNumber(ctx context.Context, projectID bson.ObjectID, u projectUpdate) (*models.Project, error) {
 	f := NewProjectFilter()
 	f.WithID(projectID)
 
-	u := NewProjectUpdate()
-	u.IncrementTaskRunningNumber()
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
 
-	_, err := m.collection.UpdateOne(ctx, f, u)
+	project := new(models.Project)
+	err := m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(project)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return project, nil
 }
 
 func (m *mongoProjectRepo) AddAttributeTemplates(ctx context.Context, projectID bson.ObjectID, attributeTemplate []models.AttributeTemplate) error {
@@ -1,6 +1,11 @@
 package mongo
 
-import "go.mongodb.org/mongo-driver/v2/bson"
+import (
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type sprintFilter bson.M
 
@@ -12,6 +17,22 @@ func (f sprintFilter) WithID(id bson.ObjectID) {
 	f["_id"] = id
 }
 
+func (f sprintFilter) WithProjectID(projectID bson.ObjectID) {
+	f["project_id"] = projectID
+}
+
+func (f sprintFilter) WithStatus(status models.SprintStatus) {
+	f["status"] = status
+}
+
+func (f sprintFilter) WithStartDateFrom(startDate time.Time) {
+	f["start_date"] = bson.M{"$gte": startDate}
+}
+
+func (f sprintFilter) WithEndDateTo(endDate time.Time) {
+	f["end_date"] = bson.M{"$lte": endDate}
+}
+
 type sprintUpdater bson.M
 
 func NewSprintUpdater() sprintUpdater {
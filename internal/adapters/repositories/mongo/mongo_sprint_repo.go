@@ -3,13 +3,16 @@ package mongo
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type mongoSprintRepo struct {
@@ -29,6 +32,7 @@ func (m *mongoSprintRepo) Create(ctx context.Context, sprint *repositories.Creat
 		ID:        bson.NewObjectID(),
 		ProjectID: sprint.ProjectID,
 		Title:     sprint.Title,
+		Status:    models.SprintStatusPlanned,
 		CreatedAt: time.Now(),
 		CreatedBy: sprint.CreatedBy,
 		UpdatedAt: time.Now(),
@@ -82,3 +86,109 @@ func (m *mongoSprintRepo) Update(ctx context.Context, sprint *repositories.Updat
 
 	return nil
 }
+
+func (m *mongoSprintRepo) FindByProjectIDAndStatus(ctx context.Context, projectID bson.ObjectID, status models.SprintStatus) ([]*models.Sprint, error) {
+	f := NewSprintFilter()
+	f.WithProjectID(projectID)
+	f.WithStatus(status)
+
+	cursor, err := m.collection.Find(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	sprints := []*models.Sprint{}
+	if err := cursor.All(ctx, &sprints); err != nil {
+		return nil, err
+	}
+
+	return sprints, nil
+}
+
+func (m *mongoSprintRepo) UpdateStatus(ctx context.Context, in *repositories.UpdateSprintStatusRequest) error {
+	f := NewSprintFilter()
+	f.WithID(in.ID)
+
+	u := bson.M{
+		"$set": bson.M{
+			"status":     in.Status,
+			"start_date": in.StartDate,
+			"end_date":   in.EndDate,
+			"updated_at": time.Now(),
+			"updated_by": in.UpdatedBy,
+		},
+	}
+
+	_, err := m.collection.UpdateOne(ctx, f, u)
+	if mongo.IsDuplicateKeyError(err) {
+		return repositories.ErrDuplicateKey
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *mongoSprintRepo) UpdateRetrospective(ctx context.Context, in *repositories.UpdateSprintRetrospectiveRequest) error {
+	f := NewSprintFilter()
+	f.WithID(in.ID)
+
+	u := bson.M{
+		"$set": bson.M{
+			"retrospective": in.Retrospective,
+			"updated_at":    time.Now(),
+			"updated_by":    in.UpdatedBy,
+		},
+	}
+
+	_, err := m.collection.UpdateOne(ctx, f, u)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *mongoSprintRepo) Search(ctx context.Context, in *repositories.SearchSprintRequest) ([]*models.Sprint, int64, error) {
+	f := NewSprintFilter()
+	f.WithProjectID(in.ProjectID)
+
+	if in.Status != "" {
+		f.WithStatus(in.Status)
+	}
+	if in.StartDateFrom != nil {
+		f.WithStartDateFrom(*in.StartDateFrom)
+	}
+	if in.EndDateTo != nil {
+		f.WithEndDateTo(*in.EndDateTo)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSkip(int64((in.PaginationRequest.Page - 1) * in.PaginationRequest.PageSize))
+	findOptions.SetLimit(int64(in.PaginationRequest.PageSize))
+
+	sortOrder := 1
+	if strings.ToUpper(in.PaginationRequest.Order) == constant.DESC {
+		sortOrder = -1
+	}
+	findOptions.SetSort(bson.D{{Key: in.PaginationRequest.SortBy, Value: sortOrder}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	sprints := []*models.Sprint{}
+	if err := cursor.All(ctx, &sprints); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sprints, total, nil
+}
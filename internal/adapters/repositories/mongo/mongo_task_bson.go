@@ -1,6 +1,12 @@
 package mongo
 
-import "go.mongodb.org/mongo-driver/v2/bson"
+import (
+	"regexp"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type taskFilter bson.M
 
@@ -16,8 +22,184 @@ func (f taskFilter) WithTaskID(taskID string) {
 	f["task_id"] = taskID
 }
 
+func (f taskFilter) WithProjectID(projectID bson.ObjectID) {
+	f["project_id"] = projectID
+}
+
+func (f taskFilter) WithStatus(status string) {
+	f["status"] = status
+}
+
+func (f taskFilter) WithType(taskType models.TaskType) {
+	f["type"] = taskType
+}
+
+func (f taskFilter) WithPriority(priority models.TaskPriority) {
+	f["priority"] = priority
+}
+
+func (f taskFilter) WithAssigneeUserID(userID bson.ObjectID) {
+	f["assignee.value"] = userID
+}
+
+func (f taskFilter) WithSprintID(sprintID bson.ObjectID) {
+	f["sprint.current_sprint_id"] = sprintID
+}
+
+// WithSprintIDInHistory matches tasks that are in the sprint or were moved out of it
+func (f taskFilter) WithSprintIDInHistory(sprintID bson.ObjectID) {
+	f["$or"] = bson.A{
+		bson.M{"sprint.current_sprint_id": sprintID},
+		bson.M{"sprint.previous_sprint_ids": sprintID},
+	}
+}
+
+// WithNoSprint matches tasks in the backlog, where the sprint or its current sprint ID is missing or null
+func (f taskFilter) WithNoSprint() {
+	f["sprint.current_sprint_id"] = nil
+}
+
+func (f taskFilter) WithTaskIDs(taskIDs []string) {
+	f["task_id"] = bson.M{"$in": taskIDs}
+}
+
+// WithSprintIDNot matches tasks whose current sprint differs from sprintID (nil means the backlog)
+func (f taskFilter) WithSprintIDNot(sprintID *bson.ObjectID) {
+	f["sprint.current_sprint_id"] = bson.M{"$ne": sprintID}
+}
+
+func (f taskFilter) WithStatusNotIn(statuses []string) {
+	f["status"] = bson.M{"$nin": statuses}
+}
+
+func (f taskFilter) WithParentID(parentID string) {
+	f["parent_id"] = parentID
+}
+
+func (f taskFilter) WithTitleKeyword(keyword string) {
+	f["title"] = bson.M{"$regex": regexp.QuoteMeta(keyword), "$options": "i"}
+}
+
+func (f taskFilter) WithAttribute(key string, value interface{}) {
+	f["attributes"] = bson.M{
+		"$elemMatch": bson.M{
+			"key":   key,
+			"value": value,
+		},
+	}
+}
+
+func (f taskFilter) WithEmbeddingModel(model string) {
+	f["embedding_model"] = model
+}
+
+func (f taskFilter) WithEmbeddingModelNot(model string) {
+	f["embedding_model"] = bson.M{"$ne": model}
+}
+
+func (f taskFilter) WithUpdatedAtNotAfter(updatedAt time.Time) {
+	f["updated_at"] = bson.M{"$lte": updatedAt}
+}
+
 type taskUpdate bson.M
 
 func NewTaskUpdate() taskUpdate {
 	return taskUpdate{}
 }
+
+func (u taskUpdate) set(key string, value interface{}) {
+	if _, ok := u["$set"]; !ok {
+		u["$set"] = bson.M{}
+	}
+	u["$set"].(bson.M)[key] = value
+}
+
+func (u taskUpdate) WithTitle(title string) {
+	u.set("title", title)
+}
+
+func (u taskUpdate) WithDescription(description *string) {
+	u.set("description", description)
+}
+
+func (u taskUpdate) WithPriority(priority *models.TaskPriority) {
+	u.set("priority", priority)
+}
+
+func (u taskUpdate) WithStatus(status string) {
+	u.set("status", status)
+}
+
+func (u taskUpdate) WithAttributes(attributes []models.KeyValuePair) {
+	u.set("attributes", attributes)
+}
+
+func (u taskUpdate) WithMentions(mentions []models.Mention) {
+	u.set("mentions", mentions)
+}
+
+func (u taskUpdate) WithAssignees(assignees []models.TaskAssignee) {
+	u.set("assignee", assignees)
+}
+
+func (u taskUpdate) WithApprovals(approvals []models.TaskApproval) {
+	u.set("approval", approvals)
+}
+
+func (u taskUpdate) WithEmbedding(embedding []float32, model string) {
+	u.set("embedding", embedding)
+	u.set("embedding_model", model)
+}
+
+// WithStaleEmbedding marks the embedding as outdated so it is generated again
+func (u taskUpdate) WithStaleEmbedding() {
+	u["$unset"] = bson.M{"embedding_model": ""}
+}
+
+func (u taskUpdate) WithUpdatedBy(updatedBy bson.ObjectID) {
+	u.set("updated_by", updatedBy)
+	u.set("updated_at", time.Now())
+}
+
+// NewTaskCarryOverSprintPipeline builds an update pipeline that moves a task from fromSprintID
+// to toSprintID (or the backlog when toSprintID is nil) and records fromSprintID in the sprint history.
+func NewTaskCarryOverSprintPipeline(fromSprintID bson.ObjectID, toSprintID *bson.ObjectID, updatedBy bson.ObjectID) []bson.M {
+	return newTaskSprintPipeline(
+		bson.M{"$concatArrays": bson.A{previousSprintIDsExpr, bson.A{fromSprintID}}},
+		toSprintID,
+		updatedBy,
+	)
+}
+
+// NewTaskMoveSprintPipeline builds an update pipeline that moves a task to toSprintID (or the backlog
+// when toSprintID is nil) and records the task's current sprint, if any, in the sprint history.
+func NewTaskMoveSprintPipeline(toSprintID *bson.ObjectID, updatedBy bson.ObjectID) []bson.M {
+	return newTaskSprintPipeline(
+		bson.M{
+			"$cond": bson.M{
+				"if":   bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$sprint.current_sprint_id", nil}}, nil}},
+				"then": previousSprintIDsExpr,
+				"else": bson.M{"$concatArrays": bson.A{previousSprintIDsExpr, bson.A{"$sprint.current_sprint_id"}}},
+			},
+		},
+		toSprintID,
+		updatedBy,
+	)
+}
+
+var previousSprintIDsExpr = bson.M{"$ifNull": bson.A{"$sprint.previous_sprint_ids", bson.A{}}}
+
+func newTaskSprintPipeline(previousSprintIDs interface{}, toSprintID *bson.ObjectID, updatedBy bson.ObjectID) []bson.M {
+	return []bson.M{
+		{
+			"$set": bson.M{
+				"sprint": bson.M{
+					"previous_sprint_ids": previousSprintIDs,
+					"current_sprint_id":   toSprintID,
+				},
+				"updated_at": time.Now(),
+				"updated_by": updatedBy,
+			},
+		},
+	}
+}
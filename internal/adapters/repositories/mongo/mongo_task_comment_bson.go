@@ -1,6 +1,11 @@
 package mongo
 
-import "go.mongodb.org/mongo-driver/v2/bson"
+import (
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
 
 type taskCommentFilter bson.M
 
@@ -22,8 +27,43 @@ func (f taskCommentFilter) WithTaskID(taskID string) {
 	f["task_id"] = taskID
 }
 
+func (f taskCommentFilter) WithTaskIDs(taskIDs []string) {
+	f["task_id"] = bson.M{
+		"$in": taskIDs,
+	}
+}
+
+func (f taskCommentFilter) WithNotDeleted() {
+	f["deleted_at"] = nil
+}
+
 type taskCommentUpdate bson.M
 
 func NewTaskCommentUpdate() taskCommentUpdate {
 	return taskCommentUpdate{}
 }
+
+func (u taskCommentUpdate) set(key string, value interface{}) {
+	if _, ok := u["$set"]; !ok {
+		u["$set"] = bson.M{}
+	}
+	u["$set"].(bson.M)[key] = value
+}
+
+func (u taskCommentUpdate) WithContent(content string) {
+	now := time.Now()
+	u.set("content", content)
+	u.set("edited_at", now)
+	u.set("updated_at", now)
+}
+
+func (u taskCommentUpdate) WithMentions(mentions []models.Mention) {
+	u.set("mentions", mentions)
+}
+
+func (u taskCommentUpdate) WithDeleted(deletedBy bson.ObjectID) {
+	now := time.Now()
+	u.set("deleted_at", now)
+	u.set("deleted_by", deletedBy)
+	u.set("updated_at", now)
+}
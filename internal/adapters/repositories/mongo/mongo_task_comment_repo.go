@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
@@ -9,6 +10,7 @@ import (
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type mongoTaskCommentRepo struct {
@@ -31,6 +33,8 @@ func (m *mongoTaskCommentRepo) Create(ctx context.Context, taskComment *reposito
 		Content:   taskComment.Content,
 		UserID:    taskComment.UserID,
 		TaskID:    taskComment.TaskID,
+		ParentID:  taskComment.ParentID,
+		Mentions:  taskComment.Mentions,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -47,10 +51,13 @@ func (m *mongoTaskCommentRepo) FindByTaskID(ctx context.Context, taskID string)
 	f := NewTaskCommentFilter()
 	f.WithTaskID(taskID)
 
-	cursor, err := m.collection.Find(ctx, f)
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
 	if err != nil {
 		return nil, err
 	}
+	defer cursor.Close(ctx)
 
 	var taskComments []*models.TaskComment
 	if err := cursor.All(ctx, &taskComments); err != nil {
@@ -59,3 +66,76 @@ func (m *mongoTaskCommentRepo) FindByTaskID(ctx context.Context, taskID string)
 
 	return taskComments, nil
 }
+
+// FindByTaskIDs returns the comments that are not deleted, oldest first
+func (m *mongoTaskCommentRepo) FindByTaskIDs(ctx context.Context, taskIDs []string) ([]*models.TaskComment, error) {
+	f := NewTaskCommentFilter()
+	f.WithTaskIDs(taskIDs)
+	f.WithNotDeleted()
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	taskComments := []*models.TaskComment{}
+	if err := cursor.All(ctx, &taskComments); err != nil {
+		return nil, err
+	}
+
+	return taskComments, nil
+}
+
+func (m *mongoTaskCommentRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models.TaskComment, error) {
+	f := NewTaskCommentFilter()
+	f.WithID(id)
+
+	taskComment := new(models.TaskComment)
+	err := m.collection.FindOne(ctx, f).Decode(taskComment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return taskComment, nil
+}
+
+func (m *mongoTaskCommentRepo) UpdateContent(ctx context.Context, in *repositories.UpdateTaskCommentContentRequest) (*models.TaskComment, error) {
+	f := NewTaskCommentFilter()
+	f.WithID(in.ID)
+	f.WithNotDeleted()
+
+	u := NewTaskCommentUpdate()
+	u.WithContent(in.Content)
+	u.WithMentions(in.Mentions)
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	taskComment := new(models.TaskComment)
+	err := m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(taskComment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return taskComment, nil
+}
+
+func (m *mongoTaskCommentRepo) SoftDelete(ctx context.Context, in *repositories.SoftDeleteTaskCommentRequest) error {
+	f := NewTaskCommentFilter()
+	f.WithID(in.ID)
+	f.WithNotDeleted()
+
+	u := NewTaskCommentUpdate()
+	u.WithDeleted(in.DeletedBy)
+
+	_, err := m.collection.UpdateOne(ctx, f, u)
+	return err
+}
@@ -3,13 +3,16 @@ package mongo
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 type mongoTaskRepo struct {
@@ -37,6 +40,8 @@ func (m *mongoTaskRepo) Create(ctx context.Context, task *repositories.CreateTas
 		Type:        task.Type,
 		Status:      task.Status,
 		Sprint:      task.Sprint,
+		Attributes:  task.Attributes,
+		Mentions:    task.Mentions,
 		CreatedAt:   time.Now(),
 		CreatedBy:   task.CreatedBy,
 		UpdatedAt:   time.Now(),
@@ -69,12 +74,263 @@ func (m *mongoTaskRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models
 }
 
 func (m *mongoTaskRepo) FindByTaskID(ctx context.Context, taskID string) (*models.Task, error) {
-	task := new(models.Task)
-
 	f := NewTaskFilter()
 	f.WithTaskID(taskID)
 
-	err := m.collection.FindOne(ctx, f).Decode(task)
+	// A second match means the ID is shared by projects with the same prefix, picking one could expose the other
+	cursor, err := m.collection.Find(ctx, f, options.Find().SetLimit(2))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	tasks := []*models.Task{}
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	switch len(tasks) {
+	case 0:
+		return nil, nil
+	case 1:
+		return tasks[0], nil
+	default:
+		return nil, repositories.ErrAmbiguousTaskID
+	}
+}
+
+func (m *mongoTaskRepo) FindByProjectIDAndTaskIDs(ctx context.Context, projectID bson.ObjectID, taskIDs []string) ([]*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithTaskIDs(taskIDs)
+
+	return m.find(ctx, f)
+}
+
+func (m *mongoTaskRepo) FindBySprintID(ctx context.Context, projectID bson.ObjectID, sprintID bson.ObjectID, excludeStatuses []string) ([]*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithSprintID(sprintID)
+	if len(excludeStatuses) > 0 {
+		f.WithStatusNotIn(excludeStatuses)
+	}
+
+	return m.find(ctx, f)
+}
+
+func (m *mongoTaskRepo) FindBySprintHistory(ctx context.Context, projectID bson.ObjectID, sprintID bson.ObjectID) ([]*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithSprintIDInHistory(sprintID)
+
+	return m.find(ctx, f)
+}
+
+func (m *mongoTaskRepo) find(ctx context.Context, f taskFilter) ([]*models.Task, error) {
+	cursor, err := m.collection.Find(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	tasks := []*models.Task{}
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (m *mongoTaskRepo) UpdateDetail(ctx context.Context, in *repositories.UpdateTaskDetailRequest) (*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithID(in.ID)
+
+	u := NewTaskUpdate()
+	u.WithTitle(in.Title)
+	u.WithDescription(in.Description)
+	u.WithPriority(in.Priority)
+	u.WithAttributes(in.Attributes)
+	u.WithMentions(in.Mentions)
+	u.WithStaleEmbedding()
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	return m.findOneAndUpdate(ctx, f, u)
+}
+
+func (m *mongoTaskRepo) UpdateStatus(ctx context.Context, in *repositories.UpdateTaskStatusRequest) (*models.Task, error) {
+	// The transition was validated from FromStatus, so the update must not apply to a task moved since
+	f := NewTaskFilter()
+	f.WithID(in.ID)
+	f.WithStatus(in.FromStatus)
+
+	u := NewTaskUpdate()
+	u.WithStatus(in.Status)
+	u.WithApprovals([]models.TaskApproval{})
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	task, err := m.findOneAndUpdate(ctx, f, u)
+	if err != nil {
+		return nil, err
+	} else if task == nil {
+		return nil, repositories.ErrWriteConflict
+	}
+
+	return task, nil
+}
+
+func (m *mongoTaskRepo) UpdateAssignees(ctx context.Context, in *repositories.UpdateTaskAssigneesRequest) (*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithID(in.ID)
+
+	u := NewTaskUpdate()
+	u.WithAssignees(in.Assignees)
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	return m.findOneAndUpdate(ctx, f, u)
+}
+
+func (m *mongoTaskRepo) UpdateApprovals(ctx context.Context, in *repositories.UpdateTaskApprovalsRequest) (*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithID(in.ID)
+
+	u := NewTaskUpdate()
+	u.WithApprovals(in.Approvals)
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	return m.findOneAndUpdate(ctx, f, u)
+}
+
+func (m *mongoTaskRepo) CarryOverSprintTasks(ctx context.Context, in *repositories.CarryOverSprintTasksRequest) (int64, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(in.ProjectID)
+	f.WithSprintID(in.FromSprintID)
+	if len(in.ExcludeStatuses) > 0 {
+		f.WithStatusNotIn(in.ExcludeStatuses)
+	}
+
+	u := NewTaskCarryOverSprintPipeline(in.FromSprintID, in.ToSprintID, in.UpdatedBy)
+
+	result, err := m.collection.UpdateMany(ctx, f, u)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+func (m *mongoTaskRepo) UpdateSprint(ctx context.Context, in *repositories.UpdateTasksSprintRequest) (int64, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(in.ProjectID)
+	f.WithTaskIDs(in.TaskIDs)
+	f.WithSprintIDNot(in.SprintID)
+
+	u := NewTaskMoveSprintPipeline(in.SprintID, in.UpdatedBy)
+
+	result, err := m.collection.UpdateMany(ctx, f, u)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+func (m *mongoTaskRepo) CountByStatus(ctx context.Context, projectID bson.ObjectID, status string) (int64, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithStatus(status)
+
+	return m.collection.CountDocuments(ctx, f)
+}
+
+func (m *mongoTaskRepo) ReplaceStatus(ctx context.Context, in *repositories.ReplaceTaskStatusRequest) (int64, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(in.ProjectID)
+	f.WithStatus(in.FromStatus)
+
+	u := NewTaskUpdate()
+	u.WithStatus(in.ToStatus)
+	if in.ClearApprovals {
+		u.WithApprovals([]models.TaskApproval{})
+	}
+	u.WithUpdatedBy(in.UpdatedBy)
+
+	result, err := m.collection.UpdateMany(ctx, f, u)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
+func (m *mongoTaskRepo) UpdateEmbedding(ctx context.Context, in *repositories.UpdateTaskEmbeddingRequest) error {
+	f := NewTaskFilter()
+	f.WithID(in.ID)
+	f.WithUpdatedAtNotAfter(in.UpdatedAt)
+
+	u := NewTaskUpdate()
+	u.WithEmbedding(in.Embedding, in.Model)
+
+	_, err := m.collection.UpdateOne(ctx, f, u)
+	return err
+}
+
+func (m *mongoTaskRepo) FindEmbeddingsByProjectID(ctx context.Context, projectID bson.ObjectID, model string) ([]*models.TaskEmbedding, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithEmbeddingModel(model)
+
+	opts := options.Find().SetProjection(bson.M{
+		"task_id":         1,
+		"title":           1,
+		"type":            1,
+		"status":          1,
+		"embedding":       1,
+		"embedding_model": 1,
+	})
+
+	cursor, err := m.collection.Find(ctx, f, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	embeddings := []*models.TaskEmbedding{}
+	if err := cursor.All(ctx, &embeddings); err != nil {
+		return nil, err
+	}
+
+	return embeddings, nil
+}
+
+func (m *mongoTaskRepo) FindWithoutEmbedding(ctx context.Context, projectID bson.ObjectID, model string, limit int) ([]*models.Task, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(projectID)
+	f.WithEmbeddingModelNot(model)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: constant.TaskFieldCreatedAt, Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := m.collection.Find(ctx, f, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	tasks := []*models.Task{}
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (m *mongoTaskRepo) findOneAndUpdate(ctx context.Context, f taskFilter, u taskUpdate) (*models.Task, error) {
+	task := new(models.Task)
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(task)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, nil
@@ -84,3 +340,63 @@ func (m *mongoTaskRepo) FindByTaskID(ctx context.Context, taskID string) (*model
 
 	return task, nil
 }
+
+func (m *mongoTaskRepo) Search(ctx context.Context, in *repositories.SearchTaskRequest) ([]*models.Task, int64, error) {
+	f := NewTaskFilter()
+	f.WithProjectID(in.ProjectID)
+
+	if in.Status != "" {
+		f.WithStatus(in.Status)
+	}
+	if in.Type != "" {
+		f.WithType(in.Type)
+	}
+	if in.Priority != "" {
+		f.WithPriority(in.Priority)
+	}
+	if in.AssigneeID != nil {
+		f.WithAssigneeUserID(*in.AssigneeID)
+	}
+	if in.IsBacklog {
+		f.WithNoSprint()
+	} else if in.SprintID != nil {
+		f.WithSprintID(*in.SprintID)
+	}
+	if in.ParentID != "" {
+		f.WithParentID(in.ParentID)
+	}
+	if in.Keyword != "" {
+		f.WithTitleKeyword(in.Keyword)
+	}
+	if in.AttributeKey != "" {
+		f.WithAttribute(in.AttributeKey, in.AttributeValue)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSkip(int64((in.PaginationRequest.Page - 1) * in.PaginationRequest.PageSize))
+	findOptions.SetLimit(int64(in.PaginationRequest.PageSize))
+
+	sortOrder := 1
+	if strings.ToUpper(in.PaginationRequest.Order) == constant.DESC {
+		sortOrder = -1
+	}
+	findOptions.SetSort(bson.D{{Key: in.PaginationRequest.SortBy, Value: sortOrder}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	tasks := []*models.Task{}
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
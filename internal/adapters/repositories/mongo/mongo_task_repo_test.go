@@ -0,0 +1,28 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestFindByTaskIDRejectsTaskIDsSharedByProjects(t *testing.T) {
+	ctx := context.Background()
+	cfg, client := newTestMongo(t)
+
+	_, err := client.Database(cfg.MongoDB.Database).Collection("tasks").InsertMany(ctx, []interface{}{
+		bson.M{"_id": bson.NewObjectID(), "project_id": bson.NewObjectID(), "task_id": "SAME-1"},
+		bson.M{"_id": bson.NewObjectID(), "project_id": bson.NewObjectID(), "task_id": "SAME-1"},
+	})
+	if err != nil {
+		t.Fatalf("failed to insert tasks: %v", err)
+	}
+
+	_, err = NewMongoTaskRepo(cfg, client).FindByTaskID(ctx, "SAME-1")
+	if !errors.Is(err, repositories.ErrAmbiguousTaskID) {
+		t.Fatalf("FindByTaskID() error = %v, want %v", err, repositories.ErrAmbiguousTaskID)
+	}
+}
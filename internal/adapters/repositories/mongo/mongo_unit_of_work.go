@@ -0,0 +1,33 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type mongoUnitOfWork struct {
+	client *mongo.Client
+}
+
+// NewMongoUnitOfWork requires MongoDB to run as a replica set or sharded cluster, transactions are not available on a standalone server
+func NewMongoUnitOfWork(mongoClient *mongo.Client) repositories.UnitOfWork {
+	return &mongoUnitOfWork{
+		client: mongoClient,
+	}
+}
+
+func (m *mongoUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := m.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx context.Context) (interface{}, error) {
+		return nil, fn(ctx)
+	})
+
+	return err
+}
@@ -0,0 +1,83 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type webhookFilter bson.M
+
+func NewWebhookFilter() webhookFilter {
+	return webhookFilter{}
+}
+
+func (f webhookFilter) WithID(id bson.ObjectID) {
+	f["_id"] = id
+}
+
+func (f webhookFilter) WithProjectID(projectID bson.ObjectID) {
+	f["project_id"] = projectID
+}
+
+func (f webhookFilter) WithEvent(event models.WebhookEvent) {
+	f["events"] = event
+}
+
+type webhookDeliveryFilter bson.M
+
+func NewWebhookDeliveryFilter() webhookDeliveryFilter {
+	return webhookDeliveryFilter{}
+}
+
+func (f webhookDeliveryFilter) WithID(id bson.ObjectID) {
+	f["_id"] = id
+}
+
+func (f webhookDeliveryFilter) WithWebhookID(webhookID bson.ObjectID) {
+	f["webhook_id"] = webhookID
+}
+
+func (f webhookDeliveryFilter) WithStatus(status models.WebhookDeliveryStatus) {
+	f["status"] = status
+}
+
+func (f webhookDeliveryFilter) WithDueBefore(now time.Time) {
+	f["next_attempt_at"] = bson.M{"$lte": now}
+}
+
+type webhookDeliveryUpdate bson.M
+
+func NewWebhookDeliveryUpdate() webhookDeliveryUpdate {
+	return webhookDeliveryUpdate{}
+}
+
+func (u webhookDeliveryUpdate) set(key string, value interface{}) {
+	if _, ok := u["$set"]; !ok {
+		u["$set"] = bson.M{}
+	}
+	u["$set"].(bson.M)[key] = value
+}
+
+func (u webhookDeliveryUpdate) WithStatus(status models.WebhookDeliveryStatus) {
+	u.set("status", status)
+}
+
+func (u webhookDeliveryUpdate) WithAttempts(attempts int) {
+	u.set("attempts", attempts)
+}
+
+func (u webhookDeliveryUpdate) WithNextAttemptAt(nextAttemptAt *time.Time) {
+	u.set("next_attempt_at", nextAttemptAt)
+}
+
+func (u webhookDeliveryUpdate) WithLastAttempt(responseStatus *int, lastError *string) {
+	u.set("last_attempt_at", time.Now())
+	u.set("response_status", responseStatus)
+	u.set("last_error", lastError)
+}
+
+func (u webhookDeliveryUpdate) WithUpdatedAt() {
+	u.set("updated_at", time.Now())
+}
@@ -0,0 +1,171 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoWebhookDeliveryRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoWebhookDeliveryRepo(config *config.Config, mongoClient *mongo.Client) repositories.WebhookDeliveryRepository {
+	return &mongoWebhookDeliveryRepo{
+		collection: mongoClient.Database(config.MongoDB.Database).Collection("webhook_deliveries"),
+	}
+}
+
+func (m *mongoWebhookDeliveryRepo) CreateMany(ctx context.Context, in []*repositories.CreateWebhookDeliveryRequest) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	deliveries := make([]interface{}, 0, len(in))
+	for _, delivery := range in {
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			ID:            bson.NewObjectID(),
+			WebhookID:     delivery.WebhookID,
+			ProjectID:     delivery.ProjectID,
+			Event:         delivery.Event,
+			Payload:       delivery.Payload,
+			Status:        models.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	_, err := m.collection.InsertMany(ctx, deliveries)
+	return err
+}
+
+func (m *mongoWebhookDeliveryRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error) {
+	f := NewWebhookDeliveryFilter()
+	f.WithID(id)
+
+	delivery := new(models.WebhookDelivery)
+	err := m.collection.FindOne(ctx, f).Decode(delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (m *mongoWebhookDeliveryRepo) Search(ctx context.Context, in *repositories.SearchWebhookDeliveryRequest) ([]*models.WebhookDelivery, int64, error) {
+	f := NewWebhookDeliveryFilter()
+	f.WithWebhookID(in.WebhookID)
+
+	if in.Status != "" {
+		f.WithStatus(in.Status)
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSkip(int64((in.PaginationRequest.Page - 1) * in.PaginationRequest.PageSize))
+	findOptions.SetLimit(int64(in.PaginationRequest.PageSize))
+
+	sortOrder := 1
+	if strings.ToUpper(in.PaginationRequest.Order) == constant.DESC {
+		sortOrder = -1
+	}
+	findOptions.SetSort(bson.D{{Key: in.PaginationRequest.SortBy, Value: sortOrder}})
+
+	cursor, err := m.collection.Find(ctx, f, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	deliveries := []*models.WebhookDelivery{}
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := m.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+// ClaimDue takes the oldest pending delivery that is due and pushes its next attempt to leaseUntil,
+// so other workers skip it while it is being sent
+func (m *mongoWebhookDeliveryRepo) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time) (*models.WebhookDelivery, error) {
+	f := NewWebhookDeliveryFilter()
+	f.WithStatus(models.WebhookDeliveryStatusPending)
+	f.WithDueBefore(now)
+
+	u := NewWebhookDeliveryUpdate()
+	u.WithNextAttemptAt(&leaseUntil)
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	delivery := new(models.WebhookDelivery)
+	err := m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (m *mongoWebhookDeliveryRepo) UpdateAttempt(ctx context.Context, in *repositories.UpdateWebhookDeliveryAttemptRequest) error {
+	f := NewWebhookDeliveryFilter()
+	f.WithID(in.ID)
+
+	u := NewWebhookDeliveryUpdate()
+	u.WithStatus(in.Status)
+	u.WithAttempts(in.Attempts)
+	u.WithNextAttemptAt(in.NextAttemptAt)
+	u.WithLastAttempt(in.ResponseStatus, in.LastError)
+	u.WithUpdatedAt()
+
+	_, err := m.collection.UpdateOne(ctx, f, u)
+	return err
+}
+
+// Reset makes a delivery pending again with a fresh retry budget
+func (m *mongoWebhookDeliveryRepo) Reset(ctx context.Context, id bson.ObjectID) (*models.WebhookDelivery, error) {
+	f := NewWebhookDeliveryFilter()
+	f.WithID(id)
+
+	now := time.Now()
+	u := NewWebhookDeliveryUpdate()
+	u.WithStatus(models.WebhookDeliveryStatusPending)
+	u.WithAttempts(0)
+	u.WithNextAttemptAt(&now)
+	u.WithUpdatedAt()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	delivery := new(models.WebhookDelivery)
+	err := m.collection.FindOneAndUpdate(ctx, f, u, opts).Decode(delivery)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type mongoWebhookRepo struct {
+	collection *mongo.Collection
+}
+
+func NewMongoWebhookRepo(config *config.Config, mongoClient *mongo.Client) repositories.WebhookRepository {
+	return &mongoWebhookRepo{
+		collection: mongoClient.Database(config.MongoDB.Database).Collection("webhooks"),
+	}
+}
+
+func (m *mongoWebhookRepo) Create(ctx context.Context, in *repositories.CreateWebhookRequest) (*models.Webhook, error) {
+	newWebhook := &models.Webhook{
+		ID:        bson.NewObjectID(),
+		ProjectID: in.ProjectID,
+		URL:       in.URL,
+		Secret:    in.Secret,
+		Events:    in.Events,
+		CreatedAt: time.Now(),
+		CreatedBy: in.CreatedBy,
+	}
+
+	_, err := m.collection.InsertOne(ctx, newWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWebhook, nil
+}
+
+func (m *mongoWebhookRepo) FindByID(ctx context.Context, id bson.ObjectID) (*models.Webhook, error) {
+	f := NewWebhookFilter()
+	f.WithID(id)
+
+	webhook := new(models.Webhook)
+	err := m.collection.FindOne(ctx, f).Decode(webhook)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (m *mongoWebhookRepo) FindByProjectID(ctx context.Context, projectID bson.ObjectID) ([]*models.Webhook, error) {
+	f := NewWebhookFilter()
+	f.WithProjectID(projectID)
+
+	return m.find(ctx, f)
+}
+
+func (m *mongoWebhookRepo) FindByProjectIDAndEvent(ctx context.Context, projectID bson.ObjectID, event models.WebhookEvent) ([]*models.Webhook, error) {
+	f := NewWebhookFilter()
+	f.WithProjectID(projectID)
+	f.WithEvent(event)
+
+	return m.find(ctx, f)
+}
+
+func (m *mongoWebhookRepo) find(ctx context.Context, f webhookFilter) ([]*models.Webhook, error) {
+	cursor, err := m.collection.Find(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	webhooks := []*models.Webhook{}
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (m *mongoWebhookRepo) Delete(ctx context.Context, id bson.ObjectID) error {
+	f := NewWebhookFilter()
+	f.WithID(id)
+
+	_, err := m.collection.DeleteOne(ctx, f)
+	return err
+}
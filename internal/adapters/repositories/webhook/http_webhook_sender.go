@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+)
+
+const (
+	HeaderEvent     = "X-Task-Nexus-Event"
+	HeaderDelivery  = "X-Task-Nexus-Delivery"
+	HeaderSignature = "X-Task-Nexus-Signature-256"
+)
+
+type httpWebhookSender struct {
+	client               *http.Client
+	resolver             *net.Resolver
+	allowPrivateNetworks bool
+}
+
+func NewHttpWebhookSender(config *config.Config) repositories.WebhookSender {
+	h := &httpWebhookSender{
+		resolver:             net.DefaultResolver,
+		allowPrivateNetworks: config.Webhook.AllowPrivateNetworks,
+	}
+
+	// The address is checked again when dialing, since DNS can answer differently than it did when the webhook was created
+	dialer := &net.Dialer{
+		Timeout: config.Webhook.RequestTimeout,
+		Control: h.checkDialAddress,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the webhook host, which would skip the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	h.client = &http.Client{
+		Timeout:   config.Webhook.RequestTimeout,
+		Transport: transport,
+		// A redirect could point anywhere, so the redirect response is treated as the delivery result
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return h
+}
+
+// CheckURL resolves the URL's host and rejects it if any of its addresses is not public
+func (h *httpWebhookSender) CheckURL(ctx context.Context, rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	host := parsedURL.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return h.checkAddr(addr)
+	}
+
+	addrs, err := h.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := h.checkAddr(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkDialAddress runs after the host is resolved and before connecting, so it sees the address actually dialed
+func (h *httpWebhookSender) checkDialAddress(network string, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	return h.checkAddr(addrPort.Addr())
+}
+
+func (h *httpWebhookSender) checkAddr(addr netip.Addr) error {
+	if h.allowPrivateNetworks {
+		return nil
+	}
+
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return fmt.Errorf("address %s is not allowed", addr)
+	}
+
+	return nil
+}
+
+func (h *httpWebhookSender) Send(ctx context.Context, in *repositories.SendWebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, in.URL, bytes.NewReader(in.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, in.Event.String())
+	req.Header.Set(HeaderDelivery, in.DeliveryID.Hex())
+	req.Header.Set(HeaderSignature, "sha256="+Sign(in.Secret, in.Payload))
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return res.StatusCode, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of payload, receivers compare it with the signature header
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func newTestSender(allowPrivateNetworks bool) repositories.WebhookSender {
+	return NewHttpWebhookSender(&config.Config{
+		Webhook: config.WebhookConfig{
+			RequestTimeout:       5 * time.Second,
+			AllowPrivateNetworks: allowPrivateNetworks,
+		},
+	})
+}
+
+func TestSign(t *testing.T) {
+	got := Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+	want := "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if got != want {
+		t.Fatalf("Sign() = %s, want %s", got, want)
+	}
+}
+
+func TestSendSignsPayload(t *testing.T) {
+	deliveryID := bson.NewObjectID()
+	payload := []byte(`{"event":"task.created"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != string(payload) {
+			t.Errorf("body = %s, want %s", body, payload)
+		}
+		if got := r.Header.Get(HeaderEvent); got != models.WebhookEventTaskCreated.String() {
+			t.Errorf("%s = %s", HeaderEvent, got)
+		}
+		if got := r.Header.Get(HeaderDelivery); got != deliveryID.Hex() {
+			t.Errorf("%s = %s, want %s", HeaderDelivery, got, deliveryID.Hex())
+		}
+		if got, want := r.Header.Get(HeaderSignature), "sha256="+Sign("secret", payload); got != want {
+			t.Errorf("%s = %s, want %s", HeaderSignature, got, want)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	statusCode, err := newTestSender(true).Send(context.Background(), &repositories.SendWebhookRequest{
+		URL:        server.URL,
+		Secret:     "secret",
+		Event:      models.WebhookEventTaskCreated,
+		DeliveryID: deliveryID,
+		Payload:    payload,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if statusCode != http.StatusAccepted {
+		t.Fatalf("Send() status = %d, want %d", statusCode, http.StatusAccepted)
+	}
+}
+
+func TestSendDoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/internal", func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	statusCode, err := newTestSender(true).Send(context.Background(), &repositories.SendWebhookRequest{
+		URL:     server.URL + "/hook",
+		Payload: []byte(`{}`),
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if statusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("Send() status = %d, want %d", statusCode, http.StatusTemporaryRedirect)
+	}
+	if redirected {
+		t.Fatal("Send() followed the redirect")
+	}
+}
+
+func TestSendRejectsPrivateAddressWhenDialing(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	_, err := newTestSender(false).Send(context.Background(), &repositories.SendWebhookRequest{
+		URL:     server.URL,
+		Payload: []byte(`{}`),
+	})
+	if err == nil {
+		t.Fatal("Send() to a loopback address succeeded")
+	}
+	if called {
+		t.Fatal("Send() reached the loopback server")
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "https://93.184.216.34/hook", wantErr: false},
+		{url: "http://127.0.0.1:8080/hook", wantErr: true},
+		{url: "http://localhost/hook", wantErr: true},
+		{url: "http://10.0.0.8/hook", wantErr: true},
+		{url: "http://192.168.1.1/hook", wantErr: true},
+		{url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{url: "http://0.0.0.0/hook", wantErr: true},
+		{url: "http://[::1]/hook", wantErr: true},
+		{url: "http://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{url: "http://[fe80::1]/hook", wantErr: true},
+	}
+
+	sender := newTestSender(false)
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := sender.CheckURL(context.Background(), tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckURLAllowsPrivateNetworksWhenConfigured(t *testing.T) {
+	if err := newTestSender(true).CheckURL(context.Background(), "http://127.0.0.1/hook"); err != nil {
+		t.Fatalf("CheckURL() error = %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/labstack/echo/v4"
+)
+
+type ActivityHandler interface {
+	ListTaskActivities(c echo.Context) error
+	ListProjectActivities(c echo.Context) error
+}
+
+type activityHandlerImpl struct {
+	activityService services.ActivityService
+}
+
+func NewActivityHandler(activityService services.ActivityService) ActivityHandler {
+	return &activityHandlerImpl{
+		activityService: activityService,
+	}
+}
+
+func (h *activityHandlerImpl) ListTaskActivities(c echo.Context) error {
+	req := new(requests.ListTaskActivitiesRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	activities, err := h.activityService.ListTaskActivities(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, activities)
+}
+
+func (h *activityHandlerImpl) ListProjectActivities(c echo.Context) error {
+	req := new(requests.ListProjectActivitiesRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	activities, err := h.activityService.ListProjectActivities(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, activities)
+}
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/tokenutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
+	"github.com/labstack/echo/v4"
+)
+
+type AIHandler interface {
+	BreakdownTask(c echo.Context) error
+	BreakdownTaskStream(c echo.Context) error
+	SummarizeSprint(c echo.Context) error
+}
+
+type aiHandlerImpl struct {
+	aiService services.AIService
+	lifecycle *lifecycle.Lifecycle
+}
+
+func NewAIHandler(
+	aiService services.AIService,
+	lifecycle *lifecycle.Lifecycle,
+) AIHandler {
+	return &aiHandlerImpl{
+		aiService: aiService,
+		lifecycle: lifecycle,
+	}
+}
+
+func (h *aiHandlerImpl) BreakdownTask(c echo.Context) error {
+	req := new(requests.BreakdownTaskRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.aiService.BreakdownTask(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// BreakdownTaskStream sends the model output as Server-Sent Events while the breakdown is generated.
+// Errors found before the first token are returned as a normal error response, later ones as an error event.
+func (h *aiHandlerImpl) BreakdownTaskStream(c echo.Context) error {
+	req := new(requests.BreakdownTaskRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	if !acceptsEventStream(c) {
+		return errutils.NewError(exceptions.ErrEventStreamNotAccepted, errutils.BadRequest).ToEchoError()
+	}
+
+	// stop generating when the app starts draining, otherwise shutdown waits for the whole answer
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-h.lifecycle.Draining():
+			cancel()
+		}
+	}()
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+
+	started := false
+	res, err := h.aiService.BreakdownTaskStream(ctx, req, userClaims.ID, func(chunk string) error {
+		if !started {
+			startEventStream(c)
+			started = true
+		}
+		return writeEvent(c, constant.AIStreamEventToken, responses.AIStreamTokenResponse{Content: chunk})
+	})
+	if err != nil {
+		if !started {
+			return err.ToEchoError()
+		}
+		writeEvent(c, constant.AIStreamEventError, errutils.RestErrorResponse{
+			Status:  err.Status.String(),
+			Message: err.Message,
+		})
+		return nil
+	}
+
+	if !started {
+		startEventStream(c)
+	}
+	writeEvent(c, constant.AIStreamEventResult, res)
+
+	return nil
+}
+
+func (h *aiHandlerImpl) SummarizeSprint(c echo.Context) error {
+	req := new(requests.SummarizeSprintRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.aiService.SummarizeSprint(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
+	"github.com/labstack/echo/v4"
+)
+
+type BoardEventHandler interface {
+	Stream(c echo.Context) error
+}
+
+type boardEventHandlerImpl struct {
+	boardEventService services.BoardEventService
+	lifecycle         *lifecycle.Lifecycle
+}
+
+func NewBoardEventHandler(boardEventService services.BoardEventService, lifecycle *lifecycle.Lifecycle) BoardEventHandler {
+	return &boardEventHandlerImpl{
+		boardEventService: boardEventService,
+		lifecycle:         lifecycle,
+	}
+}
+
+// Stream sends the project's board events as Server-Sent Events until the client disconnects or the app starts draining.
+// Clients are expected to reconnect, EventSource does it by itself.
+func (h *boardEventHandlerImpl) Stream(c echo.Context) error {
+	req := new(requests.StreamBoardEventsRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	if !acceptsEventStream(c) {
+		return errutils.NewError(exceptions.ErrEventStreamNotAccepted, errutils.BadRequest).ToEchoError()
+	}
+
+	ctx := c.Request().Context()
+	events, unsubscribe, serviceErr := h.boardEventService.Subscribe(ctx, req)
+	if serviceErr != nil {
+		return serviceErr.ToEchoError()
+	}
+	defer unsubscribe()
+
+	startEventStream(c)
+	res := c.Response()
+
+	keepAlive := time.NewTicker(constant.EventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.lifecycle.Draining():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if err := writeEvent(c, event.Type.String(), event); err != nil {
+				return nil
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
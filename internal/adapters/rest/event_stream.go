@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/labstack/echo/v4"
+)
+
+// acceptsEventStream reports whether the client asked for Server-Sent Events
+func acceptsEventStream(c echo.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), constant.MIMETextEventStream)
+}
+
+// startEventStream writes the Server-Sent Events headers, nothing else can be written to the response after it
+func startEventStream(c echo.Context) {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, constant.MIMETextEventStream)
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+}
+
+// writeEvent sends data as JSON in a named event, an error means the client is gone
+func writeEvent(c echo.Context, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	res.Flush()
+
+	return nil
+}
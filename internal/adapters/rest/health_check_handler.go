@@ -3,17 +3,29 @@ package rest
 import (
 	"net/http"
 
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/responses"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
 	"github.com/labstack/echo/v4"
 )
 
-type healthCheckHandler struct{}
+type healthCheckHandler struct {
+	healthService services.HealthService
+	lifecycle     *lifecycle.Lifecycle
+}
 
 type HealthCheckHandler interface {
 	HealthCheck(c echo.Context) error
+	Live(c echo.Context) error
+	Ready(c echo.Context) error
 }
 
-func NewHealthCheckHandler() HealthCheckHandler {
-	return &healthCheckHandler{}
+func NewHealthCheckHandler(healthService services.HealthService, lifecycle *lifecycle.Lifecycle) HealthCheckHandler {
+	return &healthCheckHandler{
+		healthService: healthService,
+		lifecycle:     lifecycle,
+	}
 }
 
 // HealthCheck godoc
@@ -23,9 +35,54 @@ func NewHealthCheckHandler() HealthCheckHandler {
 //	@Accept			json
 //	@Produce		json
 //	@Success		200	{object} map[string]string{message=string}
+//	@Failure		503	{object} map[string]string{message=string}
 //	@Router			/api/health [get]
 func (h *healthCheckHandler) HealthCheck(c echo.Context) error {
+	if h.lifecycle.IsDraining() {
+		return c.JSON(http.StatusServiceUnavailable, &echo.Map{
+			"message": "not ready",
+		})
+	}
+
 	return c.JSON(http.StatusOK, &echo.Map{
 		"message": "OK",
 	})
 }
+
+// Live godoc
+//
+//	@Summary		Liveness Check
+//	@Description	Check that the process is running, dependencies are not checked
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	responses.HealthLivenessResponse
+//	@Router			/api/health/live [get]
+func (h *healthCheckHandler) Live(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.healthService.Liveness(c.Request().Context()))
+}
+
+// Ready godoc
+//
+//	@Summary		Readiness Check
+//	@Description	Check MongoDB, Redis and the configured optional dependencies
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	responses.HealthReadinessResponse
+//	@Failure		503	{object}	responses.HealthReadinessResponse
+//	@Router			/api/health/ready [get]
+func (h *healthCheckHandler) Ready(c echo.Context) error {
+	if h.lifecycle.IsDraining() {
+		return c.JSON(http.StatusServiceUnavailable, &responses.HealthReadinessResponse{
+			Status:   models.HealthStatusDown,
+			Draining: true,
+			Checks:   []responses.HealthReadinessResponseCheck{},
+		})
+	}
+
+	res := h.healthService.Readiness(c.Request().Context())
+	if res.Status != models.HealthStatusUp {
+		return c.JSON(http.StatusServiceUnavailable, res)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/tokenutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler interface {
+	List(c echo.Context) error
+	MarkAsRead(c echo.Context) error
+	MarkAllAsRead(c echo.Context) error
+	Stream(c echo.Context) error
+}
+
+type notificationHandlerImpl struct {
+	notificationService services.NotificationService
+	lifecycle           *lifecycle.Lifecycle
+}
+
+func NewNotificationHandler(notificationService services.NotificationService, lifecycle *lifecycle.Lifecycle) NotificationHandler {
+	return &notificationHandlerImpl{
+		notificationService: notificationService,
+		lifecycle:           lifecycle,
+	}
+}
+
+func (h *notificationHandlerImpl) List(c echo.Context) error {
+	req := new(requests.ListNotificationsRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.notificationService.List(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *notificationHandlerImpl) MarkAsRead(c echo.Context) error {
+	req := new(requests.MarkNotificationAsReadRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	notification, err := h.notificationService.MarkAsRead(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, notification)
+}
+
+func (h *notificationHandlerImpl) MarkAllAsRead(c echo.Context) error {
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.notificationService.MarkAllAsRead(c.Request().Context(), userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// Stream sends the user's new notifications as Server-Sent Events until the client disconnects or the app starts draining.
+// Notifications created while the client is disconnected are not replayed, clients list them after reconnecting.
+func (h *notificationHandlerImpl) Stream(c echo.Context) error {
+	if !acceptsEventStream(c) {
+		return errutils.NewError(exceptions.ErrEventStreamNotAccepted, errutils.BadRequest).ToEchoError()
+	}
+
+	ctx := c.Request().Context()
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	notifications, unsubscribe, serviceErr := h.notificationService.Subscribe(ctx, userClaims.ID)
+	if serviceErr != nil {
+		return serviceErr.ToEchoError()
+	}
+	defer unsubscribe()
+
+	startEventStream(c)
+	res := c.Response()
+
+	keepAlive := time.NewTicker(constant.EventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.lifecycle.Draining():
+			return nil
+		case notification, ok := <-notifications:
+			if !ok {
+				return nil
+			}
+
+			if err := writeEvent(c, notification.Type.String(), notification); err != nil {
+				return nil
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
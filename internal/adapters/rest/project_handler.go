@@ -21,6 +21,10 @@ type ProjectHandler interface {
 	ListMembers(c echo.Context) error
 	AddWorkflows(c echo.Context) error
 	ListWorkflows(c echo.Context) error
+	UpdateWorkflow(c echo.Context) error
+	DeleteWorkflow(c echo.Context) error
+	SetDefaultWorkflow(c echo.Context) error
+	ReorderWorkflows(c echo.Context) error
 	AddAttributeTemplates(c echo.Context) error
 	ListAttributeTemplates(c echo.Context) error
 }
@@ -203,6 +207,82 @@ func (u *projectHandlerImpl) ListWorkflows(c echo.Context) error {
 	return c.JSON(http.StatusOK, workflows)
 }
 
+func (u *projectHandlerImpl) UpdateWorkflow(c echo.Context) error {
+	req := new(requests.UpdateWorkflowRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := u.projectService.UpdateWorkflow(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (u *projectHandlerImpl) DeleteWorkflow(c echo.Context) error {
+	req := new(requests.DeleteWorkflowRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := u.projectService.DeleteWorkflow(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (u *projectHandlerImpl) SetDefaultWorkflow(c echo.Context) error {
+	req := new(requests.SetDefaultWorkflowRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := u.projectService.SetDefaultWorkflow(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (u *projectHandlerImpl) ReorderWorkflows(c echo.Context) error {
+	req := new(requests.ReorderWorkflowsRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := u.projectService.ReorderWorkflows(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 func (u *projectHandlerImpl) AddAttributeTemplates(c echo.Context) error {
 	req := new(requests.AddAttributeTemplatesRequest)
 	if err := c.Bind(req); err != nil {
@@ -14,6 +14,10 @@ type SprintHandler interface {
 	Create(c echo.Context) error
 	GetByID(c echo.Context) error
 	Edit(c echo.Context) error
+	Start(c echo.Context) error
+	Complete(c echo.Context) error
+	List(c echo.Context) error
+	MoveTasks(c echo.Context) error
 }
 
 type sprintHandlerImpl struct {
@@ -81,3 +85,79 @@ func (h *sprintHandlerImpl) Edit(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, sprint)
 }
+
+func (h *sprintHandlerImpl) Start(c echo.Context) error {
+	req := new(requests.StartSprintRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.sprintService.Start(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *sprintHandlerImpl) Complete(c echo.Context) error {
+	req := new(requests.CompleteSprintRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.sprintService.Complete(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *sprintHandlerImpl) List(c echo.Context) error {
+	req := new(requests.ListSprintsRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.sprintService.List(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *sprintHandlerImpl) MoveTasks(c echo.Context) error {
+	req := new(requests.MoveTasksToSprintRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.sprintService.MoveTasks(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -13,6 +13,8 @@ import (
 
 type TaskCommentHandler interface {
 	Create(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
 }
 
 type taskCommentHandlerImpl struct {
@@ -45,3 +47,41 @@ func (h *taskCommentHandlerImpl) Create(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, taskComment)
 }
+
+func (h *taskCommentHandlerImpl) Update(c echo.Context) error {
+	req := new(requests.UpdateTaskCommentRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	taskComment, err := h.taskCommentService.Update(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, taskComment)
+}
+
+func (h *taskCommentHandlerImpl) Delete(c echo.Context) error {
+	req := new(requests.DeleteTaskCommentRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.taskCommentService.Delete(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -14,15 +14,25 @@ import (
 type TaskHandler interface {
 	Create(c echo.Context) error
 	GetTaskDetail(c echo.Context) error
+	ListTasks(c echo.Context) error
+	UpdateDetail(c echo.Context) error
+	UpdateStatus(c echo.Context) error
+	AddAssignees(c echo.Context) error
+	ReplaceAssignees(c echo.Context) error
+	RemoveAssignee(c echo.Context) error
+	Approve(c echo.Context) error
+	ListSimilarTasks(c echo.Context) error
 }
 
 type taskHandlerImpl struct {
-	taskService services.TaskService
+	taskService           services.TaskService
+	taskSimilarityService services.TaskSimilarityService
 }
 
-func NewTaskHandler(taskService services.TaskService) TaskHandler {
+func NewTaskHandler(taskService services.TaskService, taskSimilarityService services.TaskSimilarityService) TaskHandler {
 	return &taskHandlerImpl{
-		taskService: taskService,
+		taskService:           taskService,
+		taskSimilarityService: taskSimilarityService,
 	}
 }
 
@@ -63,3 +73,154 @@ func (u *taskHandlerImpl) GetTaskDetail(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, resp)
 }
+
+func (u *taskHandlerImpl) UpdateDetail(c echo.Context) error {
+	req := new(requests.UpdateTaskDetailRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.UpdateDetail(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) UpdateStatus(c echo.Context) error {
+	req := new(requests.UpdateTaskStatusRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.UpdateStatus(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) Approve(c echo.Context) error {
+	req := new(requests.ApproveTaskRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.Approve(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) ListTasks(c echo.Context) error {
+	req := new(requests.ListTasksRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.ListTasks(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) AddAssignees(c echo.Context) error {
+	req := new(requests.AddTaskAssigneesRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.AddAssignees(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) ReplaceAssignees(c echo.Context) error {
+	req := new(requests.ReplaceTaskAssigneesRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.ReplaceAssignees(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) RemoveAssignee(c echo.Context) error {
+	req := new(requests.RemoveTaskAssigneeRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	resp, err := u.taskService.RemoveAssignee(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (u *taskHandlerImpl) ListSimilarTasks(c echo.Context) error {
+	req := new(requests.ListSimilarTasksRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	resp, err := u.taskSimilarityService.ListSimilar(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
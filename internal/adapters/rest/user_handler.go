@@ -14,6 +14,8 @@ import (
 type UserHandler interface {
 	Register(c echo.Context) error
 	Login(c echo.Context) error
+	RefreshToken(c echo.Context) error
+	Logout(c echo.Context) error
 	GetUserProfile(c echo.Context) error
 	SearchUser(c echo.Context) error
 	SetupUser(c echo.Context) error
@@ -65,6 +67,34 @@ func (u *userHandlerImpl) Login(c echo.Context) error {
 	return c.JSON(http.StatusOK, user)
 }
 
+func (u *userHandlerImpl) RefreshToken(c echo.Context) error {
+	req := new(requests.RefreshTokenRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	tokens, err := u.userService.RefreshToken(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+func (u *userHandlerImpl) Logout(c echo.Context) error {
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := u.userService.Logout(c.Request().Context(), userClaims)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 func (u *userHandlerImpl) GetUserProfile(c echo.Context) error {
 	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
 	user, err := u.userService.FindUserByEmail(c.Request().Context(), userClaims.Email)
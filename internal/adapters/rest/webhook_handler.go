@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/tokenutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/requests"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler interface {
+	Create(c echo.Context) error
+	List(c echo.Context) error
+	Delete(c echo.Context) error
+	ListDeliveries(c echo.Context) error
+	Redeliver(c echo.Context) error
+}
+
+type webhookHandlerImpl struct {
+	webhookService services.WebhookService
+}
+
+func NewWebhookHandler(webhookService services.WebhookService) WebhookHandler {
+	return &webhookHandlerImpl{
+		webhookService: webhookService,
+	}
+}
+
+func (h *webhookHandlerImpl) Create(c echo.Context) error {
+	req := new(requests.CreateWebhookRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	userClaims := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+	res, err := h.webhookService.Create(c.Request().Context(), req, userClaims.ID)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *webhookHandlerImpl) List(c echo.Context) error {
+	req := new(requests.ListWebhooksRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	webhooks, err := h.webhookService.List(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, webhooks)
+}
+
+func (h *webhookHandlerImpl) Delete(c echo.Context) error {
+	req := new(requests.DeleteWebhookRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	res, err := h.webhookService.Delete(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *webhookHandlerImpl) ListDeliveries(c echo.Context) error {
+	req := new(requests.ListWebhookDeliveriesRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	res, err := h.webhookService.ListDeliveries(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *webhookHandlerImpl) Redeliver(c echo.Context) error {
+	req := new(requests.RedeliverWebhookRequest)
+	if err := c.Bind(req); err != nil {
+		return errutils.NewError(err, errutils.BadRequest).ToEchoError()
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	delivery, err := h.webhookService.Redeliver(c.Request().Context(), req)
+	if err != nil {
+		return err.ToEchoError()
+	}
+
+	return c.JSON(http.StatusOK, delivery)
+}
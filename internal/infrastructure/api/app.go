@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	core_grpcclient "github.com/cnc-csku/task-nexus-go-lib/grpcclient"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/network"
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/grpcclient"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// App holds the REST and gRPC servers so both are built from the same repositories and services
+type App struct {
+	EchoAPI         *EchoAPI
+	GrpcServer      *GrpcServer
+	config          *config.Config
+	webhookWorker   *WebhookWorker
+	taskIndexWorker *TaskIndexWorker
+	healthMonitor   *HealthMonitor
+	lifecycle       *lifecycle.Lifecycle
+	migrationRepo   repositories.MigrationRepository
+	mongoClient     *mongo.Client
+	redisClient     *redis.Client
+	grpcClient      *grpcclient.GrpcClient
+}
+
+func NewApp(
+	echoAPI *EchoAPI,
+	grpcServer *GrpcServer,
+	config *config.Config,
+	webhookWorker *WebhookWorker,
+	taskIndexWorker *TaskIndexWorker,
+	healthMonitor *HealthMonitor,
+	lifecycle *lifecycle.Lifecycle,
+	migrationRepo repositories.MigrationRepository,
+	mongoClient *mongo.Client,
+	redisClient *redis.Client,
+	grpcClient *grpcclient.GrpcClient,
+) *App {
+	return &App{
+		EchoAPI:         echoAPI,
+		GrpcServer:      grpcServer,
+		config:          config,
+		webhookWorker:   webhookWorker,
+		taskIndexWorker: taskIndexWorker,
+		healthMonitor:   healthMonitor,
+		lifecycle:       lifecycle,
+		migrationRepo:   migrationRepo,
+		mongoClient:     mongoClient,
+		redisClient:     redisClient,
+		grpcClient:      grpcClient,
+	}
+}
+
+// Run starts the servers and background workers, then blocks until ctx is cancelled or a server fails.
+// Everything is shut down before it returns.
+func (a *App) Run(ctx context.Context, logger *logrus.Logger) error {
+	// the servers rely on the indexes, so nothing is served until the migration succeeds
+	log.Println("🔧 Migrating database...")
+	if err := a.migrationRepo.Migrate(ctx); err != nil {
+		return fmt.Errorf("failed to migrate database: %w", err)
+	}
+	log.Println("✅ Database migrated")
+
+	// create a listener on TCP port
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", a.config.GrpcServer.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	// get local IP address to display on startup
+	localIP, _ := network.GetLocalIP()
+
+	serverErr := make(chan error, 2)
+
+	// start gRPC server
+	go func() {
+		log.Printf("✅ gRPC server is running on %s:%s", localIP, a.config.GrpcServer.Port)
+
+		if err := a.GrpcServer.Server.Serve(lis); err != nil {
+			serverErr <- fmt.Errorf("failed to serve gRPC: %w", err)
+		}
+	}()
+
+	// start the rest server
+	go func() {
+		if err := a.EchoAPI.Start(logger); err != nil {
+			serverErr <- err
+		}
+	}()
+
+	a.healthMonitor.Start()
+	a.webhookWorker.Start()
+	a.taskIndexWorker.Start()
+
+	// wait for SIGINT or SIGTERM, or for a server to fail
+	var runErr error
+	select {
+	case <-ctx.Done():
+		log.Println("🛑 Shutting down...")
+	case runErr = <-serverErr:
+		log.Printf("❌ Server stopped unexpectedly, shutting down: %v\n", runErr)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.config.ShutdownDrainDelay+a.config.ShutdownTimeout)
+	defer cancel()
+
+	a.Shutdown(shutdownCtx)
+
+	return runErr
+}
+
+// Shutdown drains the app in order: stop taking traffic, finish in-flight requests and background work,
+// then close the connections they were using. Failures are logged so the remaining steps still run.
+func (a *App) Shutdown(ctx context.Context) {
+	// Readiness turns "not ready" on both servers and event streams let go of their connections
+	a.lifecycle.StartDrain()
+	if err := a.healthMonitor.Stop(ctx); err != nil {
+		log.Printf("❌ Error stopping health monitor: %v\n", err)
+	}
+
+	// Keep serving until load balancers polling readiness have stopped sending new requests
+	if a.config.ShutdownDrainDelay > 0 {
+		log.Printf("⏳ Waiting %s for load balancers to stop routing traffic...\n", a.config.ShutdownDrainDelay)
+		select {
+		case <-time.After(a.config.ShutdownDrainDelay):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := a.EchoAPI.Shutdown(ctx); err != nil {
+		log.Printf("❌ Error shutting down REST server: %v\n", err)
+	}
+
+	a.stopGrpcServer(ctx)
+
+	if err := a.webhookWorker.Stop(ctx); err != nil {
+		log.Printf("❌ Error stopping webhook worker: %v\n", err)
+	}
+
+	if err := a.taskIndexWorker.Stop(ctx); err != nil {
+		log.Printf("❌ Error stopping task index worker: %v\n", err)
+	}
+
+	if err := a.mongoClient.Disconnect(ctx); err != nil {
+		log.Printf("❌ Error disconnecting from MongoDB: %v\n", err)
+	}
+
+	if err := a.redisClient.Close(); err != nil {
+		log.Printf("❌ Error closing Redis client: %v\n", err)
+	}
+
+	// close all gRPC client connections
+	core_grpcclient.CloseAllGrpcConnections()
+	if err := a.grpcClient.Close(); err != nil {
+		log.Printf("❌ Error closing gRPC client connections: %v\n", err)
+	}
+
+	log.Println("👋 Shutdown complete")
+}
+
+// stopGrpcServer waits for in-flight RPCs, and cancels them when ctx expires first
+func (a *App) stopGrpcServer(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		a.GrpcServer.Server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Println("⚠️ gRPC server did not stop in time, closing remaining connections")
+		a.GrpcServer.Server.Stop()
+	}
+}
@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
-	"log"
+	"errors"
+	"net/http"
+	"strings"
 
 	"github.com/cnc-csku/task-nexus-go-lib/jsonvalidator"
 	"github.com/cnc-csku/task-nexus-go-lib/logging"
@@ -15,36 +17,30 @@ import (
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
 	echoSwagger "github.com/swaggo/echo-swagger"
-	"go.mongodb.org/mongo-driver/v2/mongo"
 )
 
 type EchoAPI struct {
-	echo        *echo.Echo
-	ctx         context.Context
-	config      *config.Config
-	mongoClient *mongo.Client
-	router      *router.Router
+	echo   *echo.Echo
+	config *config.Config
+	router *router.Router
 }
 
 func NewEchoAPI(
-	ctx context.Context,
 	config *config.Config,
-	mongoClient *mongo.Client,
 	router *router.Router,
 ) *EchoAPI {
 	return &EchoAPI{
-		echo:        echo.New(),
-		ctx:         ctx,
-		config:      config,
-		mongoClient: mongoClient,
-		router:      router,
+		echo:   echo.New(),
+		config: config,
+		router: router,
 	}
 }
 
+// Start blocks until the server stops, it returns nil when the server is stopped by Shutdown
 func (a *EchoAPI) Start(logger *logrus.Logger) error {
 	docs.SwaggerInfo.Title = "Task Nexus API"
 
-	e := echo.New()
+	e := a.echo
 
 	// Set up logger
 	var formatter logrus.Formatter
@@ -57,7 +53,17 @@ func (a *EchoAPI) Start(logger *logrus.Logger) error {
 	e.Use(echoMiddleware.Recover())
 
 	// Set up logging middleware
-	e.Use(logging.EchoLoggingMiddleware(logger, formatter))
+	// Event streams are skipped because the logging middleware buffers the whole response body and can not flush
+	loggingMiddleware := logging.EchoLoggingMiddleware(logger, formatter)
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		logged := loggingMiddleware(next)
+		return func(c echo.Context) error {
+			if isEventStreamRequest(c) {
+				return next(c)
+			}
+			return logged(c)
+		}
+	})
 
 	e.Use(echoMiddleware.CORSWithConfig(echoMiddleware.CORSConfig{
 		AllowOrigins: a.config.AllowOrigins,
@@ -87,16 +93,18 @@ func (a *EchoAPI) Start(logger *logrus.Logger) error {
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	err := e.Start(":" + a.config.RestServer.Port)
-	if err != nil {
-
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
 
-	defer func() {
-		if err := a.mongoClient.Disconnect(a.ctx); err != nil {
-			log.Printf("❌ Error disconnecting from MongoDB: %v\n", err)
-		}
-	}()
-
 	return nil
 }
+
+// Shutdown stops accepting connections and waits for in-flight requests until ctx expires
+func (a *EchoAPI) Shutdown(ctx context.Context) error {
+	return a.echo.Shutdown(ctx)
+}
+
+func isEventStreamRequest(c echo.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), constant.MIMETextEventStream)
+}
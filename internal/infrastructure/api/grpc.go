@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/grpcserver"
+	"github.com/cnc-csku/task-nexus/task-management/middlewares"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -11,21 +13,29 @@ import (
 )
 
 type GrpcServer struct {
-	Server *grpc.Server
-	Config *config.Config
+	Server       *grpc.Server
+	HealthServer *health.Server
+	Config       *config.Config
 }
 
 func NewGrpcServer(
 	ctx context.Context,
 	config *config.Config,
+	authInterceptor middlewares.GrpcAuthInterceptor,
+	taskServer grpcserver.TaskServer,
+	projectServer grpcserver.ProjectServer,
+	sprintServer grpcserver.SprintServer,
+	memberServer grpcserver.MemberServer,
 ) *GrpcServer {
 	opts := initOptions(config)
+	opts = append(opts, grpc.ChainUnaryInterceptor(authInterceptor.Unary()))
 
 	server := grpc.NewServer(opts...)
 
-	// Register health check
+	// Register health check, HealthMonitor sets the status from the readiness probes
 	healthServer := health.NewServer()
-	healthServer.SetServingStatus(config.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(config.ServiceName, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
 	grpc_health_v1.RegisterHealthServer(server, healthServer)
 
 	if config.GrpcServer.UseReflection {
@@ -33,10 +43,15 @@ func NewGrpcServer(
 	}
 
 	// Register services
+	taskServer.Register(server)
+	projectServer.Register(server)
+	sprintServer.Register(server)
+	memberServer.Register(server)
 
 	return &GrpcServer{
-		Server: server,
-		Config: config,
+		Server:       server,
+		HealthServer: healthServer,
+		Config:       config,
 	}
 }
 
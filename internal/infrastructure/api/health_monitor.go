@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthMonitor runs the readiness probes on an interval and reports the result through the gRPC health server
+type HealthMonitor struct {
+	config        *config.Config
+	healthService services.HealthService
+	grpcServer    *GrpcServer
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+func NewHealthMonitor(config *config.Config, healthService services.HealthService, grpcServer *GrpcServer) *HealthMonitor {
+	return &HealthMonitor{
+		config:        config,
+		healthService: healthService,
+		grpcServer:    grpcServer,
+	}
+}
+
+// Start probes once right away so the gRPC status is known before the first interval passes
+func (m *HealthMonitor) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+
+	go m.run(ctx)
+}
+
+// Stop marks every gRPC service as NOT_SERVING and waits for the running probe to finish or for ctx to expire
+func (m *HealthMonitor) Stop(ctx context.Context) error {
+	m.grpcServer.HealthServer.Shutdown()
+
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+
+	select {
+	case <-m.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *HealthMonitor) run(ctx context.Context) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.config.HealthCheck.Interval)
+	defer ticker.Stop()
+
+	lastStatus := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		if res := m.healthService.Readiness(ctx); res.Status != models.HealthStatusUp {
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if status != lastStatus {
+			log.Printf("🩺 Health status changed to %s\n", status)
+			lastStatus = status
+		}
+		m.grpcServer.HealthServer.SetServingStatus("", status)
+		m.grpcServer.HealthServer.SetServingStatus(m.config.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
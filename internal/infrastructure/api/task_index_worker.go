@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/cnc-csku/task-nexus/task-management/domain/constant"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+)
+
+// TaskIndexWorker stores the embeddings of changed tasks in the background for similarity search
+type TaskIndexWorker struct {
+	taskSimilarityService services.TaskSimilarityService
+	cancel                context.CancelFunc
+	wg                    sync.WaitGroup
+}
+
+func NewTaskIndexWorker(taskSimilarityService services.TaskSimilarityService) *TaskIndexWorker {
+	return &TaskIndexWorker{
+		taskSimilarityService: taskSimilarityService,
+	}
+}
+
+// Start runs the indexers in the background until Stop is called
+func (w *TaskIndexWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	for i := 0; i < constant.SimilarTaskIndexWorkers; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.taskSimilarityService.RunIndexer(ctx)
+		}()
+	}
+
+	log.Println("🧭 Task index worker started")
+}
+
+// Stop cancels the embeddings in progress and waits for the indexers to finish or for ctx to expire.
+// Tasks that were not indexed yet are picked up by the backfill of the next similarity search.
+func (w *TaskIndexWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("🧭 Task index worker stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
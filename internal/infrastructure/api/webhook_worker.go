@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+)
+
+// WebhookWorker polls for due webhook deliveries and sends them in the background
+type WebhookWorker struct {
+	config         *config.Config
+	webhookService services.WebhookService
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+func NewWebhookWorker(config *config.Config, webhookService services.WebhookService) *WebhookWorker {
+	return &WebhookWorker{
+		config:         config,
+		webhookService: webhookService,
+	}
+}
+
+// Start runs the worker in the background until Stop is called
+func (w *WebhookWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go w.run(ctx)
+}
+
+// Stop cancels the current poll and waits for it to finish or for ctx to expire.
+// A delivery cut off in the middle stays claimed until its lease ends and is retried after restart.
+func (w *WebhookWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *WebhookWorker) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.config.Webhook.PollInterval)
+	defer ticker.Stop()
+
+	log.Println("🪝 Webhook worker started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("🪝 Webhook worker stopped")
+			return
+		case <-ticker.C:
+			if err := w.webhookService.ProcessDueDeliveries(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️ Failed to process webhook deliveries: %v\n", err)
+			}
+		}
+	}
+}
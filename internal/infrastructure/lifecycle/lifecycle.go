@@ -0,0 +1,37 @@
+package lifecycle
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lifecycle tracks whether the app is draining before shutdown.
+// Long lived handlers watch Draining to let go of their connections so the servers can stop in time.
+type Lifecycle struct {
+	draining atomic.Bool
+	drainCh  chan struct{}
+	once     sync.Once
+}
+
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{
+		drainCh: make(chan struct{}),
+	}
+}
+
+// StartDrain marks the app as not ready, it is safe to call more than once
+func (l *Lifecycle) StartDrain() {
+	l.once.Do(func() {
+		l.draining.Store(true)
+		close(l.drainCh)
+	})
+}
+
+func (l *Lifecycle) IsDraining() bool {
+	return l.draining.Load()
+}
+
+// Draining is closed when the drain starts
+func (l *Lifecycle) Draining() <-chan struct{} {
+	return l.drainCh
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/cnc-csku/task-nexus/task-management/config"
@@ -13,6 +14,7 @@ import (
 
 type OllamaClient struct {
 	HTTPClient *http.Client
+	BaseURL    string
 }
 
 func NewOllamaClient(ctx context.Context, cfg *config.Config) *OllamaClient {
@@ -37,11 +39,40 @@ func NewOllamaClient(ctx context.Context, cfg *config.Config) *OllamaClient {
 		}
 	}
 
-	_, err := httpClient.Get("http://" + cfg.OllamaClient.Endpoint)
+	client := &OllamaClient{
+		HTTPClient: httpClient,
+		BaseURL:    ollamaBaseURL(cfg.OllamaClient.Endpoint),
+	}
+
+	// Ollama is only probed when it is the selected provider
+	if cfg.LLM.Provider != config.LLMProviderOllama {
+		return client
+	}
+
+	// AI features are optional, so the app still starts when Ollama is down
+	if cfg.OllamaClient.Endpoint == "" {
+		log.Println("Ollama is not configured")
+		return client
+	}
+
+	res, err := httpClient.Get(client.BaseURL)
 	if err != nil {
-		panic("🚫 Failed to connect to Ollama: " + err.Error())
+		log.Printf("⚠️ Failed to connect to Ollama: %v\n", err)
+		return client
 	}
+	res.Body.Close()
 
 	log.Println("🦙 Connected to Ollama")
-	return &OllamaClient{HTTPClient: httpClient}
+	return client
+}
+
+// ollamaBaseURL accepts both "host:port" and a full URL such as "https://ollama.example.com"
+func ollamaBaseURL(endpoint string) string {
+	if endpoint == "" {
+		return ""
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	return strings.TrimSuffix(endpoint, "/")
 }
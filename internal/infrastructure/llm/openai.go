@@ -0,0 +1,30 @@
+package llm
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus/task-management/config"
+)
+
+// OpenAIClient talks to the OpenAI API or any server compatible with it, such as vLLM or LM Studio
+type OpenAIClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+	APIKey     string
+}
+
+func NewOpenAIClient(cfg *config.Config) *OpenAIClient {
+	if cfg.LLM.Provider == config.LLMProviderOpenAI {
+		log.Printf("🤖 Using OpenAI-compatible API at %s\n", cfg.OpenAIClient.BaseURL)
+	}
+
+	return &OpenAIClient{
+		HTTPClient: &http.Client{
+			Transport: http.DefaultTransport,
+		},
+		BaseURL: strings.TrimSuffix(cfg.OpenAIClient.BaseURL, "/"),
+		APIKey:  cfg.OpenAIClient.APIKey,
+	}
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
 	"github.com/labstack/echo/v4"
 )
 
@@ -8,11 +9,15 @@ func (r *Router) RegisterAPIRouter(e *echo.Echo) {
 	api := e.Group("/api")
 
 	api.GET("/health", r.healthCheck.HealthCheck)
+	api.GET("/health/live", r.healthCheck.Live)
+	api.GET("/health/ready", r.healthCheck.Ready)
 
 	auth := api.Group("/auth/v1")
 	{
 		auth.POST("/register", r.user.Register)
 		auth.POST("/login", r.user.Login)
+		auth.POST("/refresh", r.user.RefreshToken)
+		auth.POST("/logout", r.user.Logout, r.authMiddleware.Middleware)
 		auth.GET("/profile", r.user.GetUserProfile, r.authMiddleware.Middleware)
 		auth.GET("/search", r.user.SearchUser, r.authMiddleware.Middleware)
 	}
@@ -20,51 +25,99 @@ func (r *Router) RegisterAPIRouter(e *echo.Echo) {
 	workspaces := api.Group("/workspaces/v1")
 	{
 		workspaces.GET("/own-workspaces", r.workspace.ListOwnWorkspace, r.authMiddleware.Middleware)
-		workspaces.GET("/:workspaceId/members", r.workspace.ListWorkspaceMembers, r.authMiddleware.Middleware)
-		workspaces.GET("/:workspaceId/my-projects", r.project.ListMyProjects, r.authMiddleware.Middleware)
+		workspaces.GET("/:workspaceId/members", r.workspace.ListWorkspaceMembers, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionWorkspaceView))
+		workspaces.GET("/:workspaceId/my-projects", r.project.ListMyProjects, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionWorkspaceView))
 	}
 
 	invitations := api.Group("/invitations/v1")
 	{
-		invitations.POST("", r.invitation.Create, r.authMiddleware.Middleware)
+		invitations.POST("", r.invitation.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionWorkspaceInvite))
 		invitations.GET("/users", r.invitation.ListForUser, r.authMiddleware.Middleware)
-		invitations.GET("/:workspaceId/workspaces/owner", r.invitation.ListForWorkspaceOwner, r.authMiddleware.Middleware)
+		invitations.GET("/:workspaceId/workspaces/owner", r.invitation.ListForWorkspaceOwner, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionWorkspaceInvite))
 		invitations.PUT("/users", r.invitation.UserResponse, r.authMiddleware.Middleware)
 	}
 
 	projects := api.Group("/projects/v1")
 	{
-		projects.POST("", r.project.Create, r.authMiddleware.Middleware)
-		projects.GET("/:projectId", r.project.GetProjectDetail, r.authMiddleware.Middleware)
+		projects.POST("", r.project.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectCreate))
+		projects.GET("/:projectId", r.project.GetProjectDetail, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
 
 		// Positions
-		projects.POST("/:projectId/positions", r.project.AddPositions, r.authMiddleware.Middleware)
-		projects.GET("/:projectId/positions", r.project.ListPositions, r.authMiddleware.Middleware)
+		projects.POST("/:projectId/positions", r.project.AddPositions, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/positions", r.project.ListPositions, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
 
 		// Members
-		projects.POST("/:projectId/members", r.project.AddMembers, r.authMiddleware.Middleware)
-		projects.GET("/:projectId/members", r.project.ListMembers, r.authMiddleware.Middleware)
+		projects.POST("/:projectId/members", r.project.AddMembers, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/members", r.project.ListMembers, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
 
 		// Workflow
-		projects.POST("/:projectId/workflows", r.project.AddWorkflows, r.authMiddleware.Middleware)
-		projects.GET("/:projectId/workflows", r.project.ListWorkflows, r.authMiddleware.Middleware)
+		projects.POST("/:projectId/workflows", r.project.AddWorkflows, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/workflows", r.project.ListWorkflows, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
+		projects.PUT("/:projectId/workflows/order", r.project.ReorderWorkflows, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.PUT("/:projectId/workflows/:status", r.project.UpdateWorkflow, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.DELETE("/:projectId/workflows/:status", r.project.DeleteWorkflow, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.PUT("/:projectId/workflows/:status/default", r.project.SetDefaultWorkflow, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
 
 		// Sprint
-		projects.POST("/:projectId/sprints", r.sprint.Create, r.authMiddleware.Middleware)
-		projects.GET("/:projectId/sprints/:sprintId", r.sprint.GetByID, r.authMiddleware.Middleware)
-		projects.PUT("/:projectId/sprints/:sprintId", r.sprint.Edit, r.authMiddleware.Middleware)
+		projects.POST("/:projectId/sprints", r.sprint.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintCreate))
+		projects.GET("/:projectId/sprints", r.sprint.List, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintView))
+		projects.GET("/:projectId/sprints/:sprintId", r.sprint.GetByID, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintView))
+		projects.PUT("/:projectId/sprints/:sprintId", r.sprint.Edit, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintEdit))
+		projects.POST("/:projectId/sprints/:sprintId/start", r.sprint.Start, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintEdit))
+		projects.POST("/:projectId/sprints/:sprintId/complete", r.sprint.Complete, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintEdit))
+		projects.POST("/:projectId/sprints/:sprintId/ai/summary", r.ai.SummarizeSprint, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionSprintEdit))
+
+		// Tasks
+		projects.GET("/:projectId/tasks", r.task.ListTasks, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskView))
+		projects.GET("/:projectId/tasks/similar", r.task.ListSimilarTasks, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskView))
+		projects.PUT("/:projectId/tasks/sprint", r.sprint.MoveTasks, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
 
 		// Attribute Templates
-		projects.POST("/:projectId/attribute-templates", r.project.AddAttributeTemplates, r.authMiddleware.Middleware)
-		projects.GET("/:projectId/attribute-templates", r.project.ListAttributeTemplates, r.authMiddleware.Middleware)
+		projects.POST("/:projectId/attribute-templates", r.project.AddAttributeTemplates, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/attribute-templates", r.project.ListAttributeTemplates, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
+
+		// Activities
+		projects.GET("/:projectId/activities", r.activity.ListProjectActivities, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
+
+		// Board events
+		projects.GET("/:projectId/events", r.boardEvent.Stream, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectView))
+
+		// Webhooks
+		projects.POST("/:projectId/webhooks", r.webhook.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/webhooks", r.webhook.List, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.DELETE("/:projectId/webhooks/:webhookId", r.webhook.Delete, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.GET("/:projectId/webhooks/:webhookId/deliveries", r.webhook.ListDeliveries, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
+		projects.POST("/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", r.webhook.Redeliver, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionProjectManage))
 	}
 
 	tasks := api.Group("/tasks/v1")
 	{
-		tasks.POST("", r.task.Create, r.authMiddleware.Middleware)
-		tasks.GET("/:taskId", r.task.GetTaskDetail, r.authMiddleware.Middleware)
+		tasks.POST("", r.task.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskCreate))
+		tasks.GET("/:taskId", r.task.GetTaskDetail, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskView))
+		tasks.PATCH("/:taskId", r.task.UpdateDetail, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
+		tasks.PATCH("/:taskId/status", r.task.UpdateStatus, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
+		tasks.POST("/:taskId/approvals", r.task.Approve, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskApprove))
+
+		tasks.POST("/:taskId/assignees", r.task.AddAssignees, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
+		tasks.PUT("/:taskId/assignees", r.task.ReplaceAssignees, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
+		tasks.DELETE("/:taskId/assignees", r.task.RemoveAssignee, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskEdit))
 
-		tasks.POST("/:taskId/comments", r.taskComment.Create, r.authMiddleware.Middleware)
+		tasks.POST("/:taskId/comments", r.taskComment.Create, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskComment))
+		tasks.PUT("/:taskId/comments/:commentId", r.taskComment.Update, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskComment))
+		tasks.DELETE("/:taskId/comments/:commentId", r.taskComment.Delete, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskComment))
+
+		tasks.GET("/:taskId/activities", r.activity.ListTaskActivities, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskView))
+
+		tasks.POST("/:taskId/ai/breakdown", r.ai.BreakdownTask, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskCreate))
+		tasks.POST("/:taskId/ai/breakdown/stream", r.ai.BreakdownTaskStream, r.authMiddleware.Middleware, r.permissionMiddleware.Require(models.PermissionTaskCreate))
+	}
+
+	notifications := api.Group("/notifications/v1")
+	{
+		notifications.GET("", r.notification.List, r.authMiddleware.Middleware)
+		notifications.GET("/stream", r.notification.Stream, r.authMiddleware.Middleware)
+		notifications.PUT("/read", r.notification.MarkAllAsRead, r.authMiddleware.Middleware)
+		notifications.PUT("/:notificationId/read", r.notification.MarkAsRead, r.authMiddleware.Middleware)
 	}
 
 	setup := api.Group("/setup/v1")
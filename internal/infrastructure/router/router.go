@@ -7,22 +7,29 @@ import (
 
 type Router struct {
 	// Handlers
-	healthCheck rest.HealthCheckHandler
-	common      rest.CommonHandler
-	user        rest.UserHandler
-	project     rest.ProjectHandler
-	invitation  rest.InvitationHandler
-	workspace   rest.WorkspaceHandler
-	sprint      rest.SprintHandler
-	task        rest.TaskHandler
-	taskComment rest.TaskCommentHandler
+	healthCheck  rest.HealthCheckHandler
+	common       rest.CommonHandler
+	user         rest.UserHandler
+	project      rest.ProjectHandler
+	invitation   rest.InvitationHandler
+	workspace    rest.WorkspaceHandler
+	sprint       rest.SprintHandler
+	task         rest.TaskHandler
+	taskComment  rest.TaskCommentHandler
+	activity     rest.ActivityHandler
+	notification rest.NotificationHandler
+	boardEvent   rest.BoardEventHandler
+	webhook      rest.WebhookHandler
+	ai           rest.AIHandler
 
 	// Middlewares
-	authMiddleware middlewares.AuthMiddleware
+	authMiddleware       middlewares.AuthMiddleware
+	permissionMiddleware middlewares.PermissionMiddleware
 }
 
 func NewRouter(
 	authMiddleware middlewares.AuthMiddleware,
+	permissionMiddleware middlewares.PermissionMiddleware,
 	healthCheck rest.HealthCheckHandler,
 	common rest.CommonHandler,
 	user rest.UserHandler,
@@ -32,17 +39,28 @@ func NewRouter(
 	sprint rest.SprintHandler,
 	task rest.TaskHandler,
 	taskComment rest.TaskCommentHandler,
+	activity rest.ActivityHandler,
+	notification rest.NotificationHandler,
+	boardEvent rest.BoardEventHandler,
+	webhook rest.WebhookHandler,
+	ai rest.AIHandler,
 ) *Router {
 	return &Router{
-		authMiddleware: authMiddleware,
-		healthCheck:    healthCheck,
-		common:         common,
-		user:           user,
-		project:        project,
-		invitation:     invitation,
-		workspace:      workspace,
-		sprint:         sprint,
-		task:           task,
-		taskComment:    taskComment,
+		authMiddleware:       authMiddleware,
+		permissionMiddleware: permissionMiddleware,
+		healthCheck:          healthCheck,
+		common:               common,
+		user:                 user,
+		project:              project,
+		invitation:           invitation,
+		workspace:            workspace,
+		sprint:               sprint,
+		task:                 task,
+		taskComment:          taskComment,
+		activity:             activity,
+		notification:         notification,
+		boardEvent:           boardEvent,
+		webhook:              webhook,
+		ai:                   ai,
 	}
 }
@@ -4,11 +4,17 @@ import (
 	core_grpcclient "github.com/cnc-csku/task-nexus-go-lib/grpcclient"
 	"github.com/cnc-csku/task-nexus/task-management/config"
 	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/grpcserver"
+	cache_repo "github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/cache"
 	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/grpcclient"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/health"
+	llm_repo "github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/llm"
 	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/mongo"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/webhook"
 	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/rest"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/cache"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/database"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/router"
 	"github.com/cnc-csku/task-nexus/task-management/middlewares"
@@ -27,7 +33,9 @@ var InfraSet = wire.NewSet(
 	database.NewMongoClient,
 	router.NewRouter,
 	llm.NewOllamaClient,
+	llm.NewOpenAIClient,
 	cache.NewRedisClient,
+	lifecycle.NewLifecycle,
 )
 
 var RepositorySet = wire.NewSet(
@@ -41,6 +49,19 @@ var RepositorySet = wire.NewSet(
 	mongo.NewMongoSprintRepo,
 	mongo.NewMongoTaskRepo,
 	mongo.NewMongoTaskCommentRepo,
+	mongo.NewMongoActivityRepo,
+	mongo.NewMongoNotificationRepo,
+	mongo.NewMongoWebhookRepo,
+	mongo.NewMongoWebhookDeliveryRepo,
+	mongo.NewMongoUnitOfWork,
+	mongo.NewMongoMigrationRepo,
+	cache_repo.NewRedisTokenRepo,
+	cache_repo.NewRedisBoardEventHub,
+	grpcclient.NewNotificationPublisher,
+	health.NewHealthCheckers,
+	webhook.NewHttpWebhookSender,
+	llm_repo.NewLLMRepository,
+	llm_repo.NewLLMEmbeddingRepository,
 )
 
 var ServiceSet = wire.NewSet(
@@ -52,6 +73,14 @@ var ServiceSet = wire.NewSet(
 	services.NewSprintService,
 	services.NewTaskService,
 	services.NewTaskCommentService,
+	services.NewAuthorizationService,
+	services.NewActivityService,
+	services.NewNotificationService,
+	services.NewBoardEventService,
+	services.NewWebhookService,
+	services.NewHealthService,
+	services.NewAIService,
+	services.NewTaskSimilarityService,
 )
 
 var RestHandlerSet = wire.NewSet(
@@ -64,6 +93,18 @@ var RestHandlerSet = wire.NewSet(
 	rest.NewSprintHandler,
 	rest.NewTaskHandler,
 	rest.NewTaskCommentHandler,
+	rest.NewActivityHandler,
+	rest.NewNotificationHandler,
+	rest.NewBoardEventHandler,
+	rest.NewWebhookHandler,
+	rest.NewAIHandler,
+)
+
+var GrpcServerSet = wire.NewSet(
+	grpcserver.NewTaskServer,
+	grpcserver.NewProjectServer,
+	grpcserver.NewSprintServer,
+	grpcserver.NewMemberServer,
 )
 
 var GrpcClientSet = wire.NewSet(
@@ -74,4 +115,6 @@ var GrpcClientSet = wire.NewSet(
 
 var MiddlewareSet = wire.NewSet(
 	middlewares.NewAdminJWTMiddleware,
+	middlewares.NewPermissionMiddleware,
+	middlewares.NewGrpcAuthInterceptor,
 )
@@ -8,32 +8,24 @@ import (
 	"github.com/google/wire"
 )
 
-func InitializeApp() *api.EchoAPI {
+func InitializeApp() *api.App {
 	wire.Build(
 		CtxSet,
 		ConfigSet,
-		// GrpcClientSet,
+		GrpcClientSet,
 		InfraSet,
 		RepositorySet,
 		ServiceSet,
 		RestHandlerSet,
+		GrpcServerSet,
 		MiddlewareSet,
+		api.NewWebhookWorker,
+		api.NewTaskIndexWorker,
+		api.NewHealthMonitor,
 		api.NewEchoAPI,
+		api.NewGrpcServer,
+		api.NewApp,
 	)
 
-	return &api.EchoAPI{}
+	return &api.App{}
 }
-
-// func InitializeGrpcServer() *api.GrpcServer {
-// 	wire.Build(
-// 		CtxSet,
-// 		ConfigSet,
-// 		GrpcClientSet,
-// 		InfraSet,
-// 		RepositorySet,
-// 		ServiceSet,
-// 		api.NewGrpcServer,
-// 	)
-
-// 	return &api.GrpcServer{}
-// }
@@ -7,51 +7,105 @@
 package wire
 
 import (
+	"github.com/cnc-csku/task-nexus-go-lib/grpcclient"
 	"github.com/cnc-csku/task-nexus/task-management/config"
 	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/grpcserver"
+	cache2 "github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/cache"
+	grpcclient2 "github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/grpcclient"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/health"
+	llm2 "github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/llm"
 	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/mongo"
+	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/repositories/webhook"
 	"github.com/cnc-csku/task-nexus/task-management/internal/adapters/rest"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/api"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/cache"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/database"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/lifecycle"
+	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/llm"
 	"github.com/cnc-csku/task-nexus/task-management/internal/infrastructure/router"
 	"github.com/cnc-csku/task-nexus/task-management/middlewares"
 )
 
 // Injectors from wire.go:
 
-func InitializeApp() *api.EchoAPI {
-	context := NewCtx()
+func InitializeApp() *api.App {
 	configConfig := config.NewConfig()
-	client := database.NewMongoClient(configConfig, context)
-	authMiddleware := middlewares.NewAdminJWTMiddleware(configConfig)
-	healthCheckHandler := rest.NewHealthCheckHandler()
-	globalSettingRepository := mongo.NewMongoGlobalSettingRepo(configConfig, client)
+	client := cache.NewRedisClient(configConfig)
+	tokenRepository := cache2.NewRedisTokenRepo(client)
+	authMiddleware := middlewares.NewAdminJWTMiddleware(configConfig, tokenRepository)
+	context := NewCtx()
+	mongoClient := database.NewMongoClient(configConfig, context)
+	workspaceMemberRepository := mongo.NewMongoWorkspaceMemberRepo(configConfig, mongoClient)
+	projectRepository := mongo.NewMongoProjectRepo(configConfig, mongoClient)
+	projectMemberRepository := mongo.NewMongoProjectMemberRepo(configConfig, mongoClient)
+	taskRepository := mongo.NewMongoTaskRepo(configConfig, mongoClient)
+	authorizationService := services.NewAuthorizationService(workspaceMemberRepository, projectRepository, projectMemberRepository, taskRepository)
+	permissionMiddleware := middlewares.NewPermissionMiddleware(authorizationService)
+	grpcClientConfig := config.ProvideGrpcClientConfig(configConfig)
+	grpcClient := grpcclient.NewGrpcClient(grpcClientConfig)
+	grpcclientGrpcClient := grpcclient2.NewGrpcClient(context, configConfig, grpcClient)
+	ollamaClient := llm.NewOllamaClient(context, configConfig)
+	openAIClient := llm.NewOpenAIClient(configConfig)
+	v := health.NewHealthCheckers(configConfig, mongoClient, client, grpcclientGrpcClient, ollamaClient, openAIClient)
+	healthService := services.NewHealthService(configConfig, v)
+	lifecycleLifecycle := lifecycle.NewLifecycle()
+	healthCheckHandler := rest.NewHealthCheckHandler(healthService, lifecycleLifecycle)
+	globalSettingRepository := mongo.NewMongoGlobalSettingRepo(configConfig, mongoClient)
 	commonService := services.NewCommonService(globalSettingRepository)
 	commonHandler := rest.NewCommonHandler(commonService)
-	userRepository := mongo.NewMongoUserRepo(configConfig, client)
-	userService := services.NewUserService(configConfig, userRepository, globalSettingRepository)
+	userRepository := mongo.NewMongoUserRepo(configConfig, mongoClient)
+	userService := services.NewUserService(configConfig, userRepository, globalSettingRepository, tokenRepository)
 	userHandler := rest.NewUserHandler(userService)
-	workspaceRepository := mongo.NewMongoWorkspaceRepo(configConfig, client)
-	workspaceMemberRepository := mongo.NewMongoWorkspaceMemberRepo(configConfig, client)
-	projectRepository := mongo.NewMongoProjectRepo(configConfig, client)
-	projectMemberRepository := mongo.NewMongoProjectMemberRepo(configConfig, client)
-	projectService := services.NewProjectService(userRepository, workspaceRepository, workspaceMemberRepository, projectRepository, projectMemberRepository, configConfig)
+	workspaceRepository := mongo.NewMongoWorkspaceRepo(configConfig, mongoClient)
+	activityRepository := mongo.NewMongoActivityRepo(configConfig, mongoClient)
+	boardEventHub := cache2.NewRedisBoardEventHub(client)
+	boardEventService := services.NewBoardEventService(boardEventHub)
+	unitOfWork := mongo.NewMongoUnitOfWork(mongoClient)
+	projectService := services.NewProjectService(userRepository, workspaceRepository, workspaceMemberRepository, projectRepository, projectMemberRepository, taskRepository, activityRepository, boardEventService, unitOfWork, configConfig)
 	projectHandler := rest.NewProjectHandler(projectService)
-	invitationRepository := mongo.NewMongoInvitationRepo(configConfig, client)
-	invitationService := services.NewInvitationService(userRepository, workspaceRepository, invitationRepository, workspaceMemberRepository, configConfig)
+	invitationRepository := mongo.NewMongoInvitationRepo(configConfig, mongoClient)
+	notificationRepository := mongo.NewMongoNotificationRepo(configConfig, mongoClient)
+	notificationPublisher := grpcclient2.NewNotificationPublisher(grpcclientGrpcClient)
+	notificationService := services.NewNotificationService(notificationRepository, notificationPublisher)
+	invitationService := services.NewInvitationService(userRepository, workspaceRepository, invitationRepository, workspaceMemberRepository, notificationService, configConfig)
 	invitationHandler := rest.NewInvitationHandler(invitationService)
 	workspaceService := services.NewWorkspaceService(workspaceRepository, globalSettingRepository, userRepository, workspaceMemberRepository)
 	workspaceHandler := rest.NewWorkspaceHandler(workspaceService)
-	sprintRepository := mongo.NewMongoSprintRepo(configConfig, client)
-	sprintService := services.NewSprintService(sprintRepository, projectRepository)
+	sprintRepository := mongo.NewMongoSprintRepo(configConfig, mongoClient)
+	webhookRepository := mongo.NewMongoWebhookRepo(configConfig, mongoClient)
+	webhookDeliveryRepository := mongo.NewMongoWebhookDeliveryRepo(configConfig, mongoClient)
+	webhookSender := webhook.NewHttpWebhookSender(configConfig)
+	webhookService := services.NewWebhookService(webhookRepository, webhookDeliveryRepository, webhookSender, projectRepository, configConfig)
+	sprintService := services.NewSprintService(sprintRepository, projectRepository, projectMemberRepository, taskRepository, activityRepository, notificationService, boardEventService, webhookService, unitOfWork)
 	sprintHandler := rest.NewSprintHandler(sprintService)
-	taskRepository := mongo.NewMongoTaskRepo(configConfig, client)
-	taskCommentRepository := mongo.NewMongoTaskCommentRepo(configConfig, client)
-	taskService := services.NewTaskService(taskRepository, projectRepository, projectMemberRepository, sprintRepository, taskCommentRepository, userRepository)
-	taskHandler := rest.NewTaskHandler(taskService)
-	taskCommentService := services.NewTaskCommentService(taskCommentRepository, taskRepository, projectRepository, projectMemberRepository)
+	taskCommentRepository := mongo.NewMongoTaskCommentRepo(configConfig, mongoClient)
+	llmRepository := llm2.NewLLMRepository(configConfig, ollamaClient, openAIClient)
+	embeddingRepository := llm2.NewLLMEmbeddingRepository(llmRepository, configConfig)
+	taskSimilarityService := services.NewTaskSimilarityService(taskRepository, embeddingRepository)
+	taskService := services.NewTaskService(taskRepository, projectRepository, projectMemberRepository, sprintRepository, taskCommentRepository, userRepository, activityRepository, notificationService, boardEventService, webhookService, taskSimilarityService, unitOfWork)
+	taskHandler := rest.NewTaskHandler(taskService, taskSimilarityService)
+	taskCommentService := services.NewTaskCommentService(taskCommentRepository, taskRepository, projectRepository, projectMemberRepository, userRepository, activityRepository, notificationService, boardEventService, webhookService, unitOfWork)
 	taskCommentHandler := rest.NewTaskCommentHandler(taskCommentService)
-	routerRouter := router.NewRouter(authMiddleware, healthCheckHandler, commonHandler, userHandler, projectHandler, invitationHandler, workspaceHandler, sprintHandler, taskHandler, taskCommentHandler)
-	echoAPI := api.NewEchoAPI(context, configConfig, client, routerRouter)
-	return echoAPI
+	activityService := services.NewActivityService(activityRepository, taskRepository)
+	activityHandler := rest.NewActivityHandler(activityService)
+	notificationHandler := rest.NewNotificationHandler(notificationService, lifecycleLifecycle)
+	boardEventHandler := rest.NewBoardEventHandler(boardEventService, lifecycleLifecycle)
+	webhookHandler := rest.NewWebhookHandler(webhookService)
+	aiService := services.NewAIService(taskRepository, taskCommentRepository, projectRepository, sprintRepository, userRepository, llmRepository, taskService, boardEventService, configConfig)
+	aiHandler := rest.NewAIHandler(aiService, lifecycleLifecycle)
+	routerRouter := router.NewRouter(authMiddleware, permissionMiddleware, healthCheckHandler, commonHandler, userHandler, projectHandler, invitationHandler, workspaceHandler, sprintHandler, taskHandler, taskCommentHandler, activityHandler, notificationHandler, boardEventHandler, webhookHandler, aiHandler)
+	echoAPI := api.NewEchoAPI(configConfig, routerRouter)
+	grpcAuthInterceptor := middlewares.NewGrpcAuthInterceptor(authMiddleware)
+	taskServer := grpcserver.NewTaskServer(taskService, authorizationService)
+	projectServer := grpcserver.NewProjectServer(projectService, authorizationService)
+	sprintServer := grpcserver.NewSprintServer(sprintService, authorizationService)
+	memberServer := grpcserver.NewMemberServer(projectService, authorizationService)
+	grpcServer := api.NewGrpcServer(context, configConfig, grpcAuthInterceptor, taskServer, projectServer, sprintServer, memberServer)
+	webhookWorker := api.NewWebhookWorker(configConfig, webhookService)
+	taskIndexWorker := api.NewTaskIndexWorker(taskSimilarityService)
+	healthMonitor := api.NewHealthMonitor(configConfig, healthService, grpcServer)
+	migrationRepository := mongo.NewMongoMigrationRepo(configConfig, mongoClient)
+	app := api.NewApp(echoAPI, grpcServer, configConfig, webhookWorker, taskIndexWorker, healthMonitor, lifecycleLifecycle, migrationRepository, mongoClient, client, grpcclientGrpcClient)
+	return app
 }
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
@@ -8,21 +9,25 @@ import (
 	"github.com/cnc-csku/task-nexus/task-management/config"
 	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
 	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/repositories"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 )
 
 type authMiddleware struct {
-	configs *config.Config
+	configs   *config.Config
+	tokenRepo repositories.TokenRepository
 }
 
 type AuthMiddleware interface {
 	Middleware(next echo.HandlerFunc) echo.HandlerFunc
+	Authenticate(ctx context.Context, tokenString string) (*models.UserCustomClaims, *errutils.Error)
 }
 
-func NewAdminJWTMiddleware(configs *config.Config) AuthMiddleware {
+func NewAdminJWTMiddleware(configs *config.Config, tokenRepo repositories.TokenRepository) AuthMiddleware {
 	return &authMiddleware{
-		configs: configs,
+		configs:   configs,
+		tokenRepo: tokenRepo,
 	}
 }
 
@@ -33,22 +38,9 @@ func (a *authMiddleware) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 			return errutils.NewError(err, errutils.Unauthorized).ToEchoError()
 		}
 
-		// Parse and validate the token
-		token, err := jwt.ParseWithClaims(tokenString, &models.UserCustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(a.configs.JWT.AccessTokenSecret), nil
-		})
-		if err != nil {
-			return errutils.NewError(err, errutils.Unauthorized).ToEchoError()
-		}
-
-		// Validate claims
-		claims, ok := token.Claims.(*models.UserCustomClaims)
-		if !ok || !token.Valid {
-			return errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized).ToEchoError()
+		claims, serviceErr := a.Authenticate(c.Request().Context(), tokenString)
+		if serviceErr != nil {
+			return serviceErr.ToEchoError()
 		}
 
 		// Set claims to context
@@ -57,3 +49,42 @@ func (a *authMiddleware) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 		return next(c)
 	}
 }
+
+// Authenticate validates an access token and makes sure it has not been revoked.
+// It is shared by the REST middleware and the gRPC interceptor.
+func (a *authMiddleware) Authenticate(ctx context.Context, tokenString string) (*models.UserCustomClaims, *errutils.Error) {
+	// Parse and validate the token
+	token, err := jwt.ParseWithClaims(tokenString, &models.UserCustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(a.configs.JWT.AccessTokenSecret), nil
+	})
+	if err != nil {
+		return nil, errutils.NewError(err, errutils.Unauthorized)
+	}
+
+	// Validate claims
+	claims, ok := token.Claims.(*models.UserCustomClaims)
+	if !ok || !token.Valid || claims.RegisteredClaims.ID == "" {
+		return nil, errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized)
+	}
+
+	// Reject tokens revoked by logout or refresh token reuse
+	isRevoked, err := a.tokenRepo.IsTokenRevoked(ctx, claims.RegisteredClaims.ID)
+	if err != nil {
+		return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+	}
+	if !isRevoked && claims.FamilyID != "" {
+		isRevoked, err = a.tokenRepo.IsTokenFamilyRevoked(ctx, claims.FamilyID)
+		if err != nil {
+			return nil, errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(err.Error())
+		}
+	}
+	if isRevoked {
+		return nil, errutils.NewError(exceptions.ErrTokenRevoked, errutils.Unauthorized)
+	}
+
+	return claims, nil
+}
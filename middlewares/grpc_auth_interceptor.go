@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcProfileKey struct{}
+
+// publicGrpcServices can be called without an access token
+var publicGrpcServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+type grpcAuthInterceptor struct {
+	authMiddleware AuthMiddleware
+}
+
+// GrpcAuthInterceptor authenticates gRPC calls with the same access token as the REST API.
+// The token is read from the "authorization" metadata as "Bearer <token>".
+type GrpcAuthInterceptor interface {
+	Unary() grpc.UnaryServerInterceptor
+}
+
+func NewGrpcAuthInterceptor(authMiddleware AuthMiddleware) GrpcAuthInterceptor {
+	return &grpcAuthInterceptor{
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (g *grpcAuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, prefix := range publicGrpcServices {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				return handler(ctx, req)
+			}
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		authorization := md.Get("authorization")
+		if len(authorization) == 0 {
+			return nil, status.Error(codes.Unauthenticated, exceptions.ErrInvalidToken.Error())
+		}
+
+		splittedToken := strings.Split(strings.TrimSpace(authorization[0]), "Bearer ")
+		if len(splittedToken) != 2 {
+			return nil, status.Error(codes.Unauthenticated, exceptions.ErrInvalidToken.Error())
+		}
+
+		claims, serviceErr := g.authMiddleware.Authenticate(ctx, splittedToken[1])
+		if serviceErr != nil {
+			if serviceErr.Status == errutils.Unauthorized {
+				return nil, status.Error(codes.Unauthenticated, serviceErr.Message)
+			}
+			return nil, status.Error(codes.Internal, serviceErr.Message)
+		}
+
+		return handler(NewGrpcProfileContext(ctx, claims), req)
+	}
+}
+
+// NewGrpcProfileContext returns a context carrying the claims of the caller
+func NewGrpcProfileContext(ctx context.Context, claims *models.UserCustomClaims) context.Context {
+	return context.WithValue(ctx, grpcProfileKey{}, claims)
+}
+
+// GetProfileOnGrpcContext returns the claims set by GrpcAuthInterceptor
+func GetProfileOnGrpcContext(ctx context.Context) (*models.UserCustomClaims, bool) {
+	claims, ok := ctx.Value(grpcProfileKey{}).(*models.UserCustomClaims)
+	return claims, ok && claims != nil
+}
@@ -0,0 +1,113 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cnc-csku/task-nexus-go-lib/utils/errutils"
+	"github.com/cnc-csku/task-nexus-go-lib/utils/tokenutils"
+	"github.com/cnc-csku/task-nexus/task-management/domain/exceptions"
+	"github.com/cnc-csku/task-nexus/task-management/domain/models"
+	"github.com/cnc-csku/task-nexus/task-management/domain/services"
+	"github.com/labstack/echo/v4"
+)
+
+type permissionMiddleware struct {
+	authorizationService services.AuthorizationService
+}
+
+// PermissionMiddleware checks the caller's workspace and project role against models.PermissionMatrix.
+// It must be registered after AuthMiddleware so the user profile is available.
+type PermissionMiddleware interface {
+	Require(permission models.Permission) echo.MiddlewareFunc
+}
+
+func NewPermissionMiddleware(authorizationService services.AuthorizationService) PermissionMiddleware {
+	return &permissionMiddleware{
+		authorizationService: authorizationService,
+	}
+}
+
+func (p *permissionMiddleware) Require(permission models.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userClaims, ok := tokenutils.GetProfileOnEchoContext(c).(*models.UserCustomClaims)
+			if !ok || userClaims == nil {
+				return errutils.NewError(exceptions.ErrInvalidToken, errutils.Unauthorized).ToEchoError()
+			}
+
+			ctx := c.Request().Context()
+
+			switch permission.Scope() {
+			case models.PermissionScopeWorkspace:
+				workspaceID, err := lookupScopeID(c, "workspaceId")
+				if err != nil {
+					return errutils.NewError(exceptions.ErrInvalidReqPayload, errutils.BadRequest).WithDebugMessage(err.Error()).ToEchoError()
+				}
+
+				member, serviceErr := p.authorizationService.AuthorizeWorkspace(ctx, workspaceID, userClaims.ID, permission)
+				if serviceErr != nil {
+					return serviceErr.ToEchoError()
+				}
+				c.Set("workspaceMember", member)
+			case models.PermissionScopeProject:
+				var serviceErr *errutils.Error
+				var member *models.ProjectMember
+				if taskID := c.Param("taskId"); taskID != "" {
+					member, serviceErr = p.authorizationService.AuthorizeTask(ctx, taskID, userClaims.ID, permission)
+				} else {
+					projectID, err := lookupScopeID(c, "projectId")
+					if err != nil {
+						return errutils.NewError(exceptions.ErrInvalidReqPayload, errutils.BadRequest).WithDebugMessage(err.Error()).ToEchoError()
+					}
+					member, serviceErr = p.authorizationService.AuthorizeProject(ctx, projectID, userClaims.ID, permission)
+				}
+				if serviceErr != nil {
+					return serviceErr.ToEchoError()
+				}
+				c.Set("projectMember", member)
+			default:
+				return errutils.NewError(exceptions.ErrInternalError, errutils.InternalServerError).WithDebugMessage(fmt.Sprintf("Unknown permission: %s", permission)).ToEchoError()
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// lookupScopeID finds a resource ID in the path params, then the query string, then the JSON body.
+// The request body is restored so handlers can still bind it.
+func lookupScopeID(c echo.Context, key string) (string, error) {
+	if value := c.Param(key); value != "" {
+		return value, nil
+	}
+
+	if value := c.QueryParam(key); value != "" {
+		return value, nil
+	}
+
+	req := c.Request()
+	if req.Body == nil {
+		return "", fmt.Errorf("%s is required", key)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", fmt.Errorf("%s is required", key)
+	}
+
+	value, ok := payload[key].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%s is required", key)
+	}
+
+	return value, nil
+}